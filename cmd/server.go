@@ -10,10 +10,15 @@ import (
 	"leaderboard/internal/leaderboard/infra/redis"
 	"leaderboard/internal/leaderboard/infra/redis/memory"
 	"leaderboard/internal/leaderboard/interface/controller"
+	"leaderboard/internal/leaderboard/usecase/auth"
+	"leaderboard/internal/leaderboard/usecase/board"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/player"
 	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/signature"
+	"leaderboard/internal/leaderboard/usecase/team"
 
 	"github.com/kataras/iris/v12"
-	"github.com/robfig/cron/v3"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -55,13 +60,29 @@ func server() {
 
 			// new memory repository
 			memory.NewRepository,
+			memory.NewBoardRepository,
+			memory.NewEntryRepository,
+			memory.NewPlayerRepository,
+			memory.NewSeasonRepository,
+			memory.NewTeamRepository,
+			memory.NewIdempotencyRepository,
+			memory.NewRuleRepository,
+			memory.NewNonceRepository,
+			memory.NewBanRepository,
+			memory.NewAuditRepository,
 
 			// new usecase
 			score.NewUseCase,
+			board.NewUseCase,
+			player.NewUseCase,
+			team.NewUseCase,
+			idempotency.NewUseCase,
+			signature.NewUseCase,
+			auth.NewUseCase,
 
 			// new http server
 			controller.NewHTTPServer,
-			controller.NewCron,
+			controller.NewScheduler,
 		),
 		fx.Invoke(start),
 	)
@@ -73,16 +94,23 @@ func server() {
 	app.Run()
 }
 
-func start(lc fx.Lifecycle, f fx.Shutdowner, h http.Handler, conf config.Config, logger *zap.Logger, c *cron.Cron) error {
+func start(lc fx.Lifecycle, f fx.Shutdowner, h http.Handler, conf config.Config, logger *zap.Logger, s *controller.Scheduler, boardUsecase board.BoardUsecase) error {
 	lc.Append(fx.Hook{
-		OnStart: func(context.Context) error {
+		OnStart: func(ctx context.Context) error {
+			// make sure the default board exists
+			if err := boardUsecase.Init(ctx); err != nil {
+				return err
+			}
+
+			// start the reset schedules of boards
+			if err := s.Start(); err != nil {
+				return err
+			}
+
 			// start server
 			go h.(*iris.Application).Run(iris.Addr(":" + conf.Port))
 			logger.Sugar().Info("start service on ", conf.Port)
 
-			// start cron job
-			go c.Start()
-
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
@@ -92,8 +120,8 @@ func start(lc fx.Lifecycle, f fx.Shutdowner, h http.Handler, conf config.Config,
 			// shutdown fx
 			f.Shutdown()
 
-			// stop cron job
-			c.Stop()
+			// stop the reset schedules
+			s.Stop()
 
 			return nil
 		},
@@ -7,6 +7,38 @@ var C Config = Config{
 		Host:     "redis:6379",
 		Database: 0,
 	},
+	Schedule: Schedule{
+		Cron:     "*/10 * * * *",
+		Timezone: "UTC",
+		TTL:      time.Minute * 10,
+		Sync:     time.Minute,
+	},
+	Season: Season{
+		Keep:      10,
+		Retention: time.Hour * 24 * 30,
+	},
+	Window: Window{
+		Windows:  []string{"daily", "weekly", "monthly"},
+		Timezone: "UTC",
+		Keep:     7,
+	},
+	Segment: Segment{
+		Segments: map[string][]string{
+			"country":  {"TW", "JP", "KR", "US"},
+			"platform": {"ios", "android", "pc"},
+		},
+	},
+	Idempotency: Idempotency{
+		TTL:   time.Hour * 24,
+		Lease: time.Second * 30,
+	},
+	Signature: Signature{
+		Window: time.Minute * 5,
+	},
+	Audit: Audit{
+		MaxLen:   100000,
+		IndexTTL: time.Hour * 24 * 30,
+	},
 }
 
 // GetConfig -
@@ -29,6 +61,144 @@ type Config struct {
 
 	// Redis
 	Redis Redis `json:"redis"`
+
+	// Schedule
+	Schedule Schedule `json:"schedule"`
+
+	// Season
+	Season Season `json:"season"`
+
+	// Window
+	Window Window `json:"window"`
+
+	// Segment
+	Segment Segment `json:"segment"`
+
+	// Idempotency
+	Idempotency Idempotency `json:"idempotency"`
+
+	// Signature
+	Signature Signature `json:"signature"`
+
+	// Auth
+	Auth Auth `json:"auth"`
+
+	// Audit
+	Audit Audit `json:"audit"`
+}
+
+// Schedule - 重置排程配置
+// the default schedule of the board which does not declare its own
+type Schedule struct {
+	// Cron cron expression of the board reset by cron
+	Cron string `json:"cron" yaml:"cron"`
+
+	// Timezone IANA timezone of cron expression
+	Timezone string `json:"timezone" yaml:"timezone"`
+
+	// TTL of the board reset by TTL
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+
+	// Sync interval to reload the schedules of boards
+	Sync time.Duration `json:"sync" yaml:"sync"`
+}
+
+// Season - 賽季保留配置
+// the seasons archived by reset are kept by count and duration, 0 means no limit
+type Season struct {
+	// Keep the number of the latest seasons kept for each board
+	Keep int64 `json:"keep" yaml:"keep"`
+
+	// Retention how long the season is kept after it ended
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
+// Window - 時間區間排行榜配置
+// every score is recorded to the board and the current bucket of each window
+type Window struct {
+	// Windows daily / weekly / monthly, the board itself is the all-time window
+	Windows []string `json:"windows" yaml:"windows"`
+
+	// Timezone IANA timezone of bucket boundaries
+	Timezone string `json:"timezone" yaml:"timezone"`
+
+	// Keep the number of the latest buckets kept for each window, the current one included
+	Keep int `json:"keep" yaml:"keep"`
+}
+
+// Segment - 分群排行榜配置
+// every score tagged with segments is also recorded to the board of each segment
+type Segment struct {
+	// Segments the allowed values by attribute name, e.g. country: [TW, JP], the other values are rejected
+	Segments map[string][]string `json:"segments" yaml:"segments"`
+}
+
+// Idempotency - 冪等請求配置
+// the result of the request with Idempotency-Key is returned to the repeated requests
+type Idempotency struct {
+	// TTL how long the key and the result of request are kept
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+
+	// Lease how long the key is reserved for the request in progress, it can be retried after the lease
+	// when the request never completes, e.g. the process crashes
+	Lease time.Duration `json:"lease" yaml:"lease"`
+}
+
+// Signature - 簽章驗證配置
+// the submissions of scores must be signed by the game servers with HMAC when it is enabled
+type Signature struct {
+	// Enabled require the signature of every submission
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// Secrets the HMAC secrets by game id
+	Secrets map[string]string `json:"secrets" yaml:"secrets"`
+
+	// Window the max difference between the timestamp of request and now, the nonces are kept for it
+	Window time.Duration `json:"window" yaml:"window"`
+}
+
+// Auth - 身分驗證配置
+// the API routes require a JWT bearer token of player or an API key of server when it is enabled
+type Auth struct {
+	// Enabled require authentication on the API routes
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// Secret the HMAC secret verifying HS256 tokens
+	Secret string `json:"secret" yaml:"secret"`
+
+	// JWKS the path of the JSON web key set verifying RS256 tokens
+	JWKS string `json:"jwks" yaml:"jwks"`
+
+	// Issuer the expected iss claim, not checked when it is empty
+	Issuer string `json:"issuer" yaml:"issuer"`
+
+	// Audience the expected aud claim, not checked when it is empty
+	Audience string `json:"audience" yaml:"audience"`
+
+	// APIKeys the static API keys of servers
+	APIKeys []APIKey `json:"apiKeys" yaml:"apiKeys"`
+}
+
+// APIKey - 伺服器金鑰
+type APIKey struct {
+	// Key the secret sent in X-Api-Key header
+	Key string `json:"key" yaml:"key"`
+
+	// Subject the name of server
+	Subject string `json:"subject" yaml:"subject"`
+
+	// Scopes submit / read / admin
+	Scopes []string `json:"scopes" yaml:"scopes"`
+}
+
+// Audit - 稽核紀錄配置
+// the score submissions, the resets and the administrative actions are appended to the audit log in a Redis stream
+type Audit struct {
+	// MaxLen about how many latest events the stream keeps, the older ones are trimmed
+	MaxLen int64 `json:"maxLen" yaml:"maxLen"`
+
+	// IndexTTL how long the index of the events of player is kept after its latest event
+	IndexTTL time.Duration `json:"indexTTL" yaml:"indexTTL"`
 }
 
 // Redis - Redis 資料庫配置
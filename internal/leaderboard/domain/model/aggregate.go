@@ -0,0 +1,79 @@
+package model
+
+// AggregateOp - how the sources of aggregated board are combined
+type AggregateOp string
+
+const (
+	// AggregateUnion - the players in any source
+	AggregateUnion AggregateOp = "union"
+
+	// AggregateIntersect - the players in every source
+	AggregateIntersect AggregateOp = "intersect"
+)
+
+// Valid - check aggregate operation is supported
+func (o AggregateOp) Valid() bool {
+	return o == AggregateUnion || o == AggregateIntersect
+}
+
+// AggregateFunc - how the weighted scores of one player in sources are combined
+type AggregateFunc string
+
+const (
+	// AggregateSum - the sum of scores
+	AggregateSum AggregateFunc = "sum"
+
+	// AggregateMin - the lowest score
+	AggregateMin AggregateFunc = "min"
+
+	// AggregateMax - the highest score
+	AggregateMax AggregateFunc = "max"
+)
+
+// Valid - check aggregate function is supported
+func (f AggregateFunc) Valid() bool {
+	return f == AggregateSum || f == AggregateMin || f == AggregateMax
+}
+
+// Aggregate - the definition of the board derived from other boards,
+// it is materialised on read and cached for TTL, or refreshed by cron
+type Aggregate struct {
+	// Op union / intersect
+	Op AggregateOp `json:"op"`
+
+	// Func sum / min / max
+	Func AggregateFunc `json:"func"`
+
+	// Sources the boards and windows combined
+	Sources []*Source `json:"sources"`
+
+	// TTL seconds the materialised board is cached
+	TTL int64 `json:"ttl"`
+
+	// Refresh cron expression to materialise the board, optional
+	Refresh string `json:"refresh,omitempty"`
+}
+
+// Source - one source of aggregated board
+type Source struct {
+	// Board board id
+	Board string `json:"board"`
+
+	// Window time window of board, all-time when it is empty
+	Window Window `json:"window,omitempty"`
+
+	// Last the number of the latest buckets of window, e.g. 7 for the last 7 daily buckets
+	Last int `json:"last,omitempty"`
+
+	// Weight the multiplier of the scores of source, 1 when it is not set, so 0 can be set explicitly
+	Weight *float64 `json:"weight"`
+}
+
+// Multiplier - the weight of source, 1 when it is not set
+func (s *Source) Multiplier() float64 {
+	if s.Weight == nil {
+		return 1
+	}
+
+	return *s.Weight
+}
@@ -0,0 +1,77 @@
+package model
+
+// AuditAction - the kind of the change audited
+type AuditAction string
+
+const (
+	// AuditCreate - the score of player is submitted
+	AuditCreate AuditAction = "create"
+
+	// AuditReset - the board is reset
+	AuditReset AuditAction = "reset"
+
+	// AuditRemove - the player is removed from board by admin
+	AuditRemove AuditAction = "remove"
+
+	// AuditSet - the score of player is set by admin
+	AuditSet AuditAction = "set"
+
+	// AuditAdjust - the score of player is adjusted by admin
+	AuditAdjust AuditAction = "adjust"
+
+	// AuditBan - the client is banned by admin
+	AuditBan AuditAction = "ban"
+
+	// AuditUnban - the ban of client is lifted by admin
+	AuditUnban AuditAction = "unban"
+
+	// AuditDelete - the board is deleted by admin
+	AuditDelete AuditAction = "delete"
+)
+
+// AuditEvent - one change of the scores or the players, appended to the audit log
+type AuditEvent struct {
+	// ID the id of event in the log, it is set when the event is read
+	ID string `json:"id,omitempty"`
+
+	Action AuditAction `json:"action"`
+
+	// Actor who made the change, it is system for the scheduled changes
+	Actor string `json:"actor"`
+
+	// IP the source IP of the request making the change
+	IP string `json:"ip,omitempty"`
+
+	// RequestID the id of the request making the change
+	RequestID string `json:"requestId,omitempty"`
+
+	// Board the board changed, empty when the change is not of one board
+	Board string `json:"board,omitempty"`
+
+	// ClientID the player changed, empty when the board is reset
+	ClientID string `json:"clientId,omitempty"`
+
+	// Previous the score before the change, nil when the player had no score
+	Previous *float64 `json:"previous,omitempty"`
+
+	// Score the score after the change, nil when the player has no score
+	Score *float64 `json:"score,omitempty"`
+
+	// Reason why the change is made
+	Reason string `json:"reason,omitempty"`
+
+	CreatedAt int64 `json:"createdAt"`
+}
+
+// AuditPage one page of the audit events, the latest first
+type AuditPage struct {
+	Events []*AuditEvent `json:"events"`
+
+	// Next cursor of next page, empty when it is the last page
+	Next string `json:"next,omitempty"`
+}
+
+// Stamp - record who made the change, where it came from and when
+func (e *AuditEvent) Stamp(origin *Origin, createdAt int64) {
+	e.Actor, e.IP, e.RequestID, e.CreatedAt = origin.Actor, origin.IP, origin.RequestID, createdAt
+}
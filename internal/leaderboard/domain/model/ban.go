@@ -0,0 +1,14 @@
+package model
+
+// Ban - the client banned from submitting scores, it is filtered out of the reads
+type Ban struct {
+	ClientID string `json:"clientId"`
+
+	// Reason why the client is banned
+	Reason string `json:"reason"`
+
+	// Actor who banned the client
+	Actor string `json:"actor"`
+
+	CreatedAt int64 `json:"createdAt"`
+}
@@ -0,0 +1,273 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultBoard - the board used by the routes without board identifier
+	DefaultBoard = "default"
+
+	// MaxTieBreakScore - the max absolute score of board with tie-break,
+	// the rest precision of float64 is used to encode the time reaching the score
+	MaxTieBreakScore = 1 << 25
+)
+
+// Order - ranking direction of board
+type Order string
+
+const (
+	// OrderDesc - highest score first
+	OrderDesc Order = "desc"
+
+	// OrderAsc - lowest score first
+	OrderAsc Order = "asc"
+)
+
+// Valid - check order is supported
+func (o Order) Valid() bool {
+	return o == OrderDesc || o == OrderAsc
+}
+
+// ResetPolicy - how the board is reset
+type ResetPolicy string
+
+const (
+	// ResetTTL - board expires when no score is recorded within TTL, every score extends it
+	ResetTTL ResetPolicy = "ttl"
+
+	// ResetCron - board is reset by the cron schedule of board
+	ResetCron ResetPolicy = "cron"
+
+	// ResetNever - board is never reset
+	ResetNever ResetPolicy = "never"
+)
+
+// Valid - check reset policy is supported
+func (p ResetPolicy) Valid() bool {
+	return p == ResetTTL || p == ResetCron || p == ResetNever
+}
+
+// UpdatePolicy - how the submitted score updates the stored score
+type UpdatePolicy string
+
+const (
+	// UpdateLatest - always replace with the submitted score
+	UpdateLatest UpdatePolicy = "latest"
+
+	// UpdateMax - keep the highest score
+	UpdateMax UpdatePolicy = "max"
+
+	// UpdateMin - keep the lowest score, e.g. speedrun
+	UpdateMin UpdatePolicy = "min"
+
+	// UpdateIncrement - accumulate the submitted score
+	UpdateIncrement UpdatePolicy = "increment"
+)
+
+// Valid - check update policy is supported
+func (p UpdatePolicy) Valid() bool {
+	return p == UpdateLatest || p == UpdateMax || p == UpdateMin || p == UpdateIncrement
+}
+
+// TieBreak - how to order the players with equal score
+type TieBreak string
+
+const (
+	// TieBreakNone - order by client id, it is the default behavior of redis
+	TieBreakNone TieBreak = "none"
+
+	// TieBreakFirst - the first to reach the score ranks higher
+	TieBreakFirst TieBreak = "first"
+
+	// TieBreakLast - the last to reach the score ranks higher
+	TieBreakLast TieBreak = "last"
+)
+
+// Valid - check tie-break is supported
+func (t TieBreak) Valid() bool {
+	return t == TieBreakNone || t == TieBreakFirst || t == TieBreakLast
+}
+
+// RankMode - how to number the players with equal score
+type RankMode string
+
+const (
+	// RankOrdinal - every player has its own rank, e.g. 1, 2, 3, 4
+	RankOrdinal RankMode = "ordinal"
+
+	// RankShared - equal scores share the rank and skip the following, e.g. 1, 2, 2, 4
+	RankShared RankMode = "shared"
+
+	// RankDense - equal scores share the rank without gap, e.g. 1, 2, 2, 3
+	RankDense RankMode = "dense"
+)
+
+// MaxDenseDepth - the dense rank counts the distinct scores of the players ranked above,
+// so it is only counted exactly within the top players, and the deeper ranks of dense board are estimated
+const MaxDenseDepth = 10000
+
+// Valid - check rank mode is supported
+func (m RankMode) Valid() bool {
+	return m == RankOrdinal || m == RankShared || m == RankDense
+}
+
+// Board
+type Board struct {
+	// ID board identifier
+	ID string `json:"id"`
+
+	// Name display name
+	Name string `json:"name"`
+
+	// Order sort order
+	Order Order `json:"order"`
+
+	// Reset reset policy
+	Reset ResetPolicy `json:"reset"`
+
+	// Update update policy
+	Update UpdatePolicy `json:"update"`
+
+	// TieBreak tie-breaker of equal score
+	TieBreak TieBreak `json:"tieBreak"`
+
+	// RankMode rank numbering of equal score
+	RankMode RankMode `json:"rankMode"`
+
+	// Cron cron expression of reset, only for the board reset by cron
+	Cron string `json:"cron,omitempty"`
+
+	// Timezone IANA timezone of cron expression, only for the board reset by cron
+	Timezone string `json:"timezone,omitempty"`
+
+	// TTL seconds to expire after the last score, only for the board reset by TTL
+	TTL int64 `json:"ttl,omitempty"`
+
+	// Aggregate the definition of the board derived from other boards, scores can not be submitted to it
+	Aggregate *Aggregate `json:"aggregate,omitempty"`
+
+	// Teams the scoring of the team standings of board, the teams are not ranked when it is nil
+	Teams *Teams `json:"teams,omitempty"`
+
+	// Rules the anti-cheat rules of the submissions, nothing is checked when it is nil
+	Rules *Rules `json:"rules,omitempty"`
+
+	CreatedAt int64 `json:"createdAt,omitempty"`
+}
+
+// Key - the sorted set key of board
+func (b *Board) Key() string {
+	return "board:" + b.ID
+}
+
+// CronSpec - the cron spec of reset with timezone
+func (b *Board) CronSpec() string {
+	return "CRON_TZ=" + b.Timezone + " " + b.Cron
+}
+
+// ExpireTime - the TTL of board reset by TTL
+func (b *Board) ExpireTime() time.Duration {
+	return time.Duration(b.TTL) * time.Second
+}
+
+// TieBreakEnabled - check the scores are encoded with time
+func (b *Board) TieBreakEnabled() bool {
+	return b.TieBreak == TieBreakFirst || b.TieBreak == TieBreakLast
+}
+
+// Aggregated - check the board is derived from other boards
+func (b *Board) Aggregated() bool {
+	return b.Aggregate != nil
+}
+
+// TeamsEnabled - check the teams of the members are ranked on board
+func (b *Board) TeamsEnabled() bool {
+	return b.Teams != nil
+}
+
+// TeamsKey - the sorted set key of the team standings of board
+func (b *Board) TeamsKey() string {
+	return b.Key() + ":teams"
+}
+
+// TeamBoard - the team standings read as a board, it ranks the team ids by the order and rank mode of board,
+// and its id can not be taken by other boards
+func (b *Board) TeamBoard() *Board {
+	return &Board{
+		ID:        b.ID + ":teams",
+		Name:      b.Name,
+		Order:     b.Order,
+		Reset:     b.Reset,
+		Update:    UpdateLatest,
+		TieBreak:  TieBreakNone,
+		RankMode:  b.RankMode,
+		TTL:       b.TTL,
+		CreatedAt: b.CreatedAt,
+	}
+}
+
+// QuarantineKey - the list key of the submissions rejected by the rules of board
+func (b *Board) QuarantineKey() string {
+	return b.Key() + ":quarantine"
+}
+
+// RateKey - the counter key of the submissions of client within the minute
+func (b *Board) RateKey(clientID string, minute int64) string {
+	return b.Key() + ":rate:" + clientID + ":" + strconv.FormatInt(minute, 10)
+}
+
+// MetaKey - the metadata key of board
+func (b *Board) MetaKey() string {
+	return b.Key() + ":meta"
+}
+
+// EntriesKey - the sorted set key of the entries of board
+func (b *Board) EntriesKey() string {
+	return b.Key() + ":entries"
+}
+
+// EntryDataKey - the hash key of the entry metadata, the field is entry id
+func (b *Board) EntryDataKey() string {
+	return b.EntriesKey() + ":data"
+}
+
+// EntrySeqKey - the sequence key generating entry id
+func (b *Board) EntrySeqKey() string {
+	return b.EntriesKey() + ":seq"
+}
+
+// SeasonsKey - the sorted set key indexing the archived seasons of board, the score is the time it ended
+func (b *Board) SeasonsKey() string {
+	return b.Key() + ":seasons"
+}
+
+// SeasonSeqKey - the sequence key generating season id
+func (b *Board) SeasonSeqKey() string {
+	return b.SeasonsKey() + ":seq"
+}
+
+// SeasonKeyPrefix - the prefix of the season keys, followed by season id
+func (b *Board) SeasonKeyPrefix() string {
+	return b.Key() + ":season:"
+}
+
+// SeasonKey - the sorted set key of the archived standings of season
+func (b *Board) SeasonKey(id int64) string {
+	return b.SeasonKeyPrefix() + strconv.FormatInt(id, 10)
+}
+
+// SegmentKey - the sorted set key of the players of board in segment
+func (b *Board) SegmentKey(s Segment) string {
+	return b.Key() + ":segment:" + s.Name + ":" + s.Value
+}
+
+// WindowKey - the sorted set key of the bucket of time window, the all-time window is the board itself
+func (b *Board) WindowKey(w Window, bucket string) string {
+	if w == WindowAllTime || w == "" {
+		return b.Key()
+	}
+
+	return b.Key() + ":" + string(w) + ":" + bucket
+}
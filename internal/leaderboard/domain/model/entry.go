@@ -0,0 +1,30 @@
+package model
+
+import "encoding/json"
+
+// Entry one submission on board, the same client can have many entries
+type Entry struct {
+	// EntryID unique id of the entry on board
+	EntryID string `json:"entryId,omitempty"`
+
+	ClientID  string  `json:"clientId"`
+	Score     float64 `json:"score"`
+	CreatedAt int64   `json:"createdAt,omitempty"`
+
+	// Metadata game context of the submission
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+
+	// Rank 1-based rank, only set when listing entries
+	Rank int64 `json:"rank,omitempty"`
+}
+
+// EntryPage one page of entries
+type EntryPage struct {
+	Entries []*Entry `json:"entries"`
+
+	// Total the number of entries on board
+	Total int64 `json:"total"`
+
+	// Next cursor of next page, empty when it is the last page
+	Next string `json:"next,omitempty"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "errors"
+
+var (
+	// ErrBoardNotFound -
+	ErrBoardNotFound = errors.New("board not found")
+
+	// ErrBoardExists -
+	ErrBoardExists = errors.New("board already exists")
+
+	// ErrPlayerNotFound -
+	ErrPlayerNotFound = errors.New("player not found")
+
+	// ErrProfileNotFound -
+	ErrProfileNotFound = errors.New("profile not found")
+
+	// ErrSeasonNotFound -
+	ErrSeasonNotFound = errors.New("season not found")
+
+	// ErrTeamNotFound -
+	ErrTeamNotFound = errors.New("team not found")
+
+	// ErrTeamFull -
+	ErrTeamFull = errors.New("team is full")
+
+	// ErrBanNotFound -
+	ErrBanNotFound = errors.New("ban not found")
+
+	// ErrNotTeamMember -
+	ErrNotTeamMember = errors.New("player is not a member of team")
+
+	// ErrScoreOutOfRange -
+	ErrScoreOutOfRange = errors.New("score is out of the range of tie-break")
+)
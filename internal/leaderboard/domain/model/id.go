@@ -0,0 +1,19 @@
+package model
+
+import "regexp"
+
+// MaxIDLength - the max length of the ids of boards, teams, clients and requests
+const MaxIDLength = 64
+
+// safeKey - the ids are used in redis keys, so only allow safe characters
+var safeKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidID - check the id is 1 to 64 letters, digits, `-` or `_`, so it is safe in redis key
+func ValidID(id string) bool {
+	return ValidKey(id, 1, MaxIDLength)
+}
+
+// ValidKey - check the part of redis key is min to max letters, digits, `-` or `_`
+func ValidKey(s string, min, max int) bool {
+	return len(s) >= min && len(s) <= max && safeKey.MatchString(s)
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidID
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{
+			name: "test valid id case",
+			in:   "adam_01-TW",
+			want: true,
+		},
+		{
+			name: "test empty id case",
+			in:   "",
+			want: false,
+		},
+		{
+			name: "test id with unsafe character case",
+			in:   "adam:profile",
+			want: false,
+		},
+		{
+			name: "test too long id case",
+			in:   strings.Repeat("a", MaxIDLength+1),
+			want: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ValidID(test.in))
+		})
+	}
+}
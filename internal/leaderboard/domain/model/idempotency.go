@@ -0,0 +1,18 @@
+package model
+
+import "encoding/json"
+
+// IdempotentRequest the request recorded by its idempotency key
+type IdempotentRequest struct {
+	// Fingerprint the hash of the request, the key can not be reused by another request
+	Fingerprint string `json:"fingerprint"`
+
+	// Done whether the request completed, the request in progress has no result
+	Done bool `json:"done"`
+
+	// Result the response of the completed request
+	Result json.RawMessage `json:"result,omitempty"`
+
+	// Error the error of the request failed after the score was written, it is responded instead of result
+	Error string `json:"error,omitempty"`
+}
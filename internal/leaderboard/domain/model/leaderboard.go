@@ -1,8 +1,93 @@
 package model
 
+import "encoding/json"
+
 // Score
 type Score struct {
 	ClientID  string  `json:"clientId"`
 	Score     float64 `json:"score"`
 	CreatedAt int64   `json:"createdAt,omitempty"`
+
+	// Metadata game context of the submission, e.g. level, character or replay id
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+
+	// Rank 1-based rank, only set when listing board
+	Rank int64 `json:"rank,omitempty"`
+
+	// Approximate the rank is estimated, only set for the deep ranks of dense board
+	Approximate bool `json:"approximate,omitempty"`
+
+	// Profile player profile, only set when listing board and the player has one
+	Profile *Profile `json:"profile,omitempty"`
+}
+
+// ScoreResult the result of recording score
+type ScoreResult struct {
+	ClientID string `json:"clientId"`
+
+	// Score the stored score after recording
+	Score float64 `json:"score"`
+
+	// Changed whether the submitted score changed the stored score
+	Changed bool `json:"changed"`
+
+	// Previous the stored score before recording, nil when the player is new
+	Previous *float64 `json:"-"`
+}
+
+// BatchResult the result of one submission of batch, Error is set when the submission is rejected
+type BatchResult struct {
+	ClientID string `json:"clientId"`
+
+	// Score the stored score after recording
+	Score float64 `json:"score"`
+
+	// Changed whether the submitted score changed the stored score
+	Changed bool `json:"changed"`
+
+	// Error the reason the submission is rejected
+	Error string `json:"error,omitempty"`
+}
+
+// ResetResult the result of resetting board
+type ResetResult struct {
+	Board string `json:"board"`
+
+	// Season the season archiving the standings, 0 when the board is empty
+	Season int64 `json:"season,omitempty"`
+
+	// Players the number of players cleared from board
+	Players int64 `json:"players"`
+
+	// Entries the number of entries cleared from board
+	Entries int64 `json:"entries"`
+}
+
+// PlayerRank
+type PlayerRank struct {
+	ClientID string  `json:"clientId"`
+	Score    float64 `json:"score"`
+
+	// Rank 1-based rank
+	Rank int64 `json:"rank"`
+
+	// Approximate the rank is estimated, only set for the deep ranks of dense board
+	Approximate bool `json:"approximate,omitempty"`
+
+	// Total the number of players on board
+	Total int64 `json:"total"`
+
+	// Percentile the percentage of players ranked at or below the player
+	Percentile float64 `json:"percentile"`
+}
+
+// ScorePage one page of leaderboard
+type ScorePage struct {
+	Scores []*Score `json:"topPlayers"`
+
+	// Total the number of players on board
+	Total int64 `json:"total"`
+
+	// Next cursor of next page, empty when it is the last page
+	Next string `json:"next,omitempty"`
 }
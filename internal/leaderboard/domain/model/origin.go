@@ -0,0 +1,40 @@
+package model
+
+import "context"
+
+// SystemActor - the actor of the changes made without request, e.g. the scheduled resets
+const SystemActor = "system"
+
+// Origin - who sends the request and where it comes from, it is recorded with the audit events
+type Origin struct {
+	// Actor the subject of the credential, anonymous when the authentication is disabled
+	Actor string
+
+	// IP the source IP of the request
+	IP string
+
+	// RequestID the id tracing the request
+	RequestID string
+}
+
+type originKey struct{}
+
+// WithOrigin - the context carrying the origin of request
+func WithOrigin(ctx context.Context, origin *Origin) context.Context {
+	return context.WithValue(ctx, originKey{}, origin)
+}
+
+// OriginFrom - the origin carried by the context, nil when the context is not of a request
+func OriginFrom(ctx context.Context) *Origin {
+	origin, _ := ctx.Value(originKey{}).(*Origin)
+	return origin
+}
+
+// ActingOrigin - the origin carried by the context, the changes without request are made by the system
+func ActingOrigin(ctx context.Context) *Origin {
+	if origin := OriginFrom(ctx); origin != nil {
+		return origin
+	}
+
+	return &Origin{Actor: SystemActor}
+}
@@ -0,0 +1,15 @@
+package model
+
+// Profile the public profile of player
+type Profile struct {
+	ClientID string `json:"clientId"`
+
+	// DisplayName the name shown on leaderboard
+	DisplayName string `json:"displayName"`
+
+	// AvatarURL http(s) url of the avatar image
+	AvatarURL string `json:"avatarUrl,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country string `json:"country,omitempty"`
+}
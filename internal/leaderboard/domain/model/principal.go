@@ -0,0 +1,38 @@
+package model
+
+// Scope - the permission granted to the authenticated caller
+type Scope string
+
+const (
+	// ScopeSubmit - submit scores and save the data of player
+	ScopeSubmit Scope = "submit"
+
+	// ScopeRead - read the boards, players and teams
+	ScopeRead Scope = "read"
+
+	// ScopeAdmin - manage the boards and teams
+	ScopeAdmin Scope = "admin"
+)
+
+// Principal - the authenticated caller
+type Principal struct {
+	// Subject the client id of player token, or the name of server API key
+	Subject string
+
+	// Scopes the permissions granted
+	Scopes []Scope
+
+	// Server whether the caller is a server authenticated by API key, it acts on behalf of players
+	Server bool
+}
+
+// Has - check the scope is granted
+func (p *Principal) Has(scope Scope) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
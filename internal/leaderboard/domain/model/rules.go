@@ -0,0 +1,49 @@
+package model
+
+import "encoding/json"
+
+// Rules - the anti-cheat rules of the submissions to board, each rule is disabled by its zero value
+type Rules struct {
+	// Min the lowest score accepted
+	Min *float64 `json:"min,omitempty"`
+
+	// Max the highest score accepted
+	Max *float64 `json:"max,omitempty"`
+
+	// MaxDelta the max change of the stored score by one submission
+	MaxDelta float64 `json:"maxDelta,omitempty"`
+
+	// RatePerMinute the max submissions of one client to board per minute
+	RatePerMinute int64 `json:"ratePerMinute,omitempty"`
+
+	// Monotonic the stored score can only increase, a lower submission is rejected
+	Monotonic bool `json:"monotonic,omitempty"`
+}
+
+// Quarantined - a submission rejected by the rules of board, kept for moderators to review
+type Quarantined struct {
+	ClientID string `json:"clientId"`
+
+	// Score the submitted score
+	Score float64 `json:"score"`
+
+	// Metadata the metadata of the submission
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+
+	// Reason the rule violated
+	Reason string `json:"reason"`
+
+	// CreatedAt the unix time the submission is rejected
+	CreatedAt int64 `json:"createdAt"`
+}
+
+// QuarantinePage one page of the quarantined submissions, the latest first
+type QuarantinePage struct {
+	Submissions []*Quarantined `json:"submissions"`
+
+	// Total the number of quarantined submissions kept for board
+	Total int64 `json:"total"`
+
+	// Next cursor of next page, empty when it is the last page
+	Next string `json:"next,omitempty"`
+}
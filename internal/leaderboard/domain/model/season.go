@@ -0,0 +1,16 @@
+package model
+
+// Season the archived standings of board, it is archived when the board resets
+type Season struct {
+	// ID sequence of season on board, starts from 1
+	ID int64 `json:"id"`
+
+	// Board board id
+	Board string `json:"board"`
+
+	// EndedAt the time the board reset
+	EndedAt int64 `json:"endedAt"`
+
+	// Total the number of players in season
+	Total int64 `json:"total"`
+}
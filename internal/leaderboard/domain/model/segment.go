@@ -0,0 +1,63 @@
+package model
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSegmentPart - the max length of the name and value of segment
+const maxSegmentPart = 32
+
+// Segment - a player attribute splitting the board, e.g. country:TW
+type Segment struct {
+	// Name attribute name, e.g. country
+	Name string
+
+	// Value attribute value, e.g. TW
+	Value string
+}
+
+// ParseSegment - parse the segment in the form of name:value
+func ParseSegment(s string) (Segment, bool) {
+	name, value := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		name, value = s[:i], s[i+1:]
+	}
+
+	segment := Segment{Name: name, Value: value}
+
+	return segment, segment.Valid()
+}
+
+// NewSegments - list the segments of the allowed values by attribute name, sorted by name and value
+func NewSegments(allowed map[string][]string) ([]Segment, bool) {
+	segments := []Segment{}
+	for name, values := range allowed {
+		for _, value := range values {
+			s := Segment{Name: name, Value: value}
+			if !s.Valid() {
+				return nil, false
+			}
+			segments = append(segments, s)
+		}
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].Name != segments[j].Name {
+			return segments[i].Name < segments[j].Name
+		}
+		return segments[i].Value < segments[j].Value
+	})
+
+	return segments, true
+}
+
+// Valid - check the name and value of segment are safe in redis key
+func (s Segment) Valid() bool {
+	return ValidKey(s.Name, 1, maxSegmentPart) && ValidKey(s.Value, 1, maxSegmentPart)
+}
+
+// String - name:value
+func (s Segment) String() string {
+	return s.Name + ":" + s.Value
+}
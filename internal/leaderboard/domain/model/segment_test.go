@@ -0,0 +1,61 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseSegment
+func TestParseSegment(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		wantSegment Segment
+		wantOK      bool
+	}{
+		{
+			name:        "test parse segment case",
+			in:          "country:TW",
+			wantSegment: Segment{Name: "country", Value: "TW"},
+			wantOK:      true,
+		},
+		{
+			name:        "test parse segment without value case",
+			in:          "country",
+			wantSegment: Segment{Name: "country"},
+			wantOK:      false,
+		},
+		{
+			name:        "test parse segment with unsafe value case",
+			in:          "country:T*",
+			wantSegment: Segment{Name: "country", Value: "T*"},
+			wantOK:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ParseSegment(test.in)
+			assert.Equal(t, test.wantSegment, got)
+			assert.Equal(t, test.wantOK, ok)
+		})
+	}
+}
+
+// TestNewSegments
+func TestNewSegments(t *testing.T) {
+	got, ok := NewSegments(map[string][]string{
+		"platform": {"pc", "ios"},
+		"country":  {"TW"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, []Segment{
+		{Name: "country", Value: "TW"},
+		{Name: "platform", Value: "ios"},
+		{Name: "platform", Value: "pc"},
+	}, got)
+
+	_, ok = NewSegments(map[string][]string{"country": {"T:W"}})
+	assert.False(t, ok)
+}
@@ -0,0 +1,19 @@
+package model
+
+// Team - a clan of players, one player is a member of at most one team
+type Team struct {
+	// ID team identifier
+	ID string `json:"id"`
+
+	// Name display name
+	Name string `json:"name"`
+
+	// Members the client ids of members
+	Members []string `json:"members"`
+}
+
+// Teams - how the score of team is computed from the scores of its members on board
+type Teams struct {
+	// Top the number of the best members summed, 0 sums all members
+	Top int64 `json:"top"`
+}
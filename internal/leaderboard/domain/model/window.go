@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Window - the time window of board, the scores of window are kept in the bucket of the current period
+type Window string
+
+const (
+	// WindowAllTime - the board itself, it is not bucketed
+	WindowAllTime Window = "alltime"
+
+	// WindowDaily - bucketed by day, e.g. 2026-10-18
+	WindowDaily Window = "daily"
+
+	// WindowWeekly - bucketed by ISO week starting on Monday, e.g. 2026-W42
+	WindowWeekly Window = "weekly"
+
+	// WindowMonthly - bucketed by month, e.g. 2026-10
+	WindowMonthly Window = "monthly"
+)
+
+// Valid - check window is supported
+func (w Window) Valid() bool {
+	return w == WindowAllTime || w == WindowDaily || w == WindowWeekly || w == WindowMonthly
+}
+
+// Start - the time the bucket containing t starts, in the location of t
+func (w Window) Start(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	switch w {
+	case WindowDaily:
+		return day
+
+	case WindowWeekly:
+		return day.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+
+	case WindowMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+
+	return time.Time{}
+}
+
+// Bucket - the name of the bucket containing t and the time it ends,
+// the boundaries are computed in the location of t
+func (w Window) Bucket(t time.Time) (string, time.Time) {
+	start := w.Start(t)
+
+	switch w {
+	case WindowDaily:
+		return start.Format("2006-01-02"), start.AddDate(0, 0, 1)
+
+	case WindowWeekly:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week), start.AddDate(0, 0, 7)
+
+	case WindowMonthly:
+		return start.Format("2006-01"), start.AddDate(0, 1, 0)
+	}
+
+	return "", time.Time{}
+}
+
+// Buckets - the names of the bucket containing t and the n-1 buckets before it, the latest first
+func (w Window) Buckets(t time.Time, n int) []string {
+	names := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		name, _ := w.Bucket(t)
+		names = append(names, name)
+
+		// the last moment of the previous bucket
+		t = w.Start(t).Add(-time.Nanosecond)
+	}
+
+	return names
+}
+
+// Expire - the time the bucket containing t expires when the latest keep buckets are kept
+func (w Window) Expire(t time.Time, keep int) time.Time {
+	_, end := w.Bucket(t)
+	for i := 1; i < keep; i++ {
+		_, end = w.Bucket(end)
+	}
+
+	return end
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWindow_Bucket
+func TestWindow_Bucket(t *testing.T) {
+	taipei := time.FixedZone("Asia/Taipei", 8*60*60)
+
+	tests := []struct {
+		name       string
+		window     Window
+		at         time.Time
+		wantBucket string
+		wantEnd    time.Time
+	}{
+		{
+			name:       "test daily bucket case",
+			window:     WindowDaily,
+			at:         time.Date(2026, 10, 18, 13, 30, 0, 0, time.UTC),
+			wantBucket: "2026-10-18",
+			wantEnd:    time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "test daily bucket in timezone case",
+			window:     WindowDaily,
+			at:         time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC).In(taipei),
+			wantBucket: "2026-10-19",
+			wantEnd:    time.Date(2026, 10, 20, 0, 0, 0, 0, taipei),
+		},
+		{
+			name:       "test weekly bucket case",
+			window:     WindowWeekly,
+			at:         time.Date(2026, 10, 18, 13, 30, 0, 0, time.UTC),
+			wantBucket: "2026-W42",
+			wantEnd:    time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "test weekly bucket across year case",
+			window:     WindowWeekly,
+			at:         time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantBucket: "2026-W53",
+			wantEnd:    time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "test monthly bucket case",
+			window:     WindowMonthly,
+			at:         time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC),
+			wantBucket: "2026-12",
+			wantEnd:    time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bucket, end := test.window.Bucket(test.at)
+			assert.Equal(t, test.wantBucket, bucket)
+			assert.True(t, test.wantEnd.Equal(end), end)
+		})
+	}
+}
+
+// TestWindow_Buckets
+func TestWindow_Buckets(t *testing.T) {
+	at := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{"2026-03-02", "2026-03-01", "2026-02-28"}, WindowDaily.Buckets(at, 3))
+	assert.Equal(t, []string{"2026-W10", "2026-W09"}, WindowWeekly.Buckets(at, 2))
+	assert.Equal(t, []string{"2026-03", "2026-02", "2026-01", "2025-12"}, WindowMonthly.Buckets(at, 4))
+}
+
+// TestWindow_Expire
+func TestWindow_Expire(t *testing.T) {
+	at := time.Date(2026, 10, 18, 13, 30, 0, 0, time.UTC)
+
+	assert.True(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC).Equal(WindowDaily.Expire(at, 1)))
+	assert.True(t, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC).Equal(WindowDaily.Expire(at, 7)))
+	assert.True(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC).Equal(WindowMonthly.Expire(at, 3)))
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// AuditRepository Repository interface for the audit log
+type AuditRepository interface {
+	// AppendAudit append the events to the log and index the events of players, the log keeps about max latest events
+	AppendAudit(ctx context.Context, events []*model.AuditEvent, max int64) error
+
+	// ListAudit list at most count events appended between from and to, the latest first,
+	// only the events appended before the event of id before are listed when it is set
+	ListAudit(ctx context.Context, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error)
+
+	// ListPlayerAudit list at most count events of player like ListAudit, only the events of player are read
+	ListPlayerAudit(ctx context.Context, clientID string, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// BanRepository Repository interface for the banned clients
+type BanRepository interface {
+	// SaveBan ban the client, the previous ban of client is replaced
+	SaveBan(ctx context.Context, ban *model.Ban) error
+
+	// DeleteBan lift the ban of client
+	DeleteBan(ctx context.Context, clientID string) error
+
+	// Banned get the banned clients among clientIDs
+	Banned(ctx context.Context, clientIDs []string) (map[string]bool, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// BoardRepository Repository interface for board metadata
+type BoardRepository interface {
+	// SaveBoard
+	SaveBoard(ctx context.Context, board *model.Board) error
+
+	// GetBoard
+	GetBoard(ctx context.Context, id string) (*model.Board, error)
+
+	// ListBoards
+	ListBoards(ctx context.Context) ([]*model.Board, error)
+
+	// DeleteBoard the latest keep buckets at the time of each window are deleted with the board
+	DeleteBoard(ctx context.Context, id string, segments []model.Segment, windows []model.Window, at time.Time, keep int) error
+}
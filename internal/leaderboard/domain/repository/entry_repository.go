@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// EntryRepository Repository interface for the entries of board, every submission is a new entry
+type EntryRepository interface {
+	// CreateEntry
+	CreateEntry(ctx context.Context, board *model.Board, in *model.Entry, ttl time.Duration) (*model.Entry, error)
+
+	// ListEntries
+	ListEntries(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Entry, error)
+
+	// CountEntries
+	CountEntries(ctx context.Context, board *model.Board) (int64, error)
+}
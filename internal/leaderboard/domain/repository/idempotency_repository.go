@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// IdempotencyRepository Repository interface for the requests recorded by idempotency key
+type IdempotencyRepository interface {
+	// Reserve record the request in progress atomically when key is not used, and return nil,
+	// otherwise the request recorded by key is returned, the key expires after ttl
+	Reserve(ctx context.Context, key string, request *model.IdempotentRequest, ttl time.Duration) (*model.IdempotentRequest, error)
+
+	// Complete replace the request in progress with the completed one, the key expires after ttl
+	Complete(ctx context.Context, key string, request *model.IdempotentRequest, ttl time.Duration) error
+
+	// Release delete key, so the request can be retried
+	Release(ctx context.Context, key string) error
+}
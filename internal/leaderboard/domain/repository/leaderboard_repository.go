@@ -8,14 +8,48 @@ import (
 
 // LeaderBoardRepository Repository interface for leaderboard service
 type LeaderBoardRepository interface {
-	// Create
-	Create(ctx context.Context, key string, score *model.Score) error
+	// Create record score by the update policy and tie-break of board
+	Create(ctx context.Context, key string, score *model.Score, board *model.Board) (*model.ScoreResult, error)
 
-	// List
-	List(ctx context.Context, key string, offset, limit int64) ([]*model.Score, error)
+	// CreateBatch record the scores in one round trip, the results are in the order of scores
+	CreateBatch(ctx context.Context, key string, scores []*model.Score, board *model.Board) ([]*model.ScoreResult, error)
 
-	// DeleteAll
-	DeleteAll(ctx context.Context) error
+	// List list members between 0-based start and stop index(inclusive) by the order of board
+	List(ctx context.Context, key string, start, stop int64, board *model.Board) ([]*model.Score, error)
+
+	// Scores get the scores of members sorted by the order of board with 1-based rank among them,
+	// the members without score are skipped
+	Scores(ctx context.Context, key string, members []string, board *model.Board) ([]*model.Score, error)
+
+	// Score get score of member
+	Score(ctx context.Context, key, member string, board *model.Board) (float64, error)
+
+	// Rank get 0-based rank of member by the order of board
+	Rank(ctx context.Context, key, member string, board *model.Board) (int64, error)
+
+	// CountBetter count the members whose score is better than score
+	CountBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, error)
+
+	// CountDistinctBetter count the distinct scores which are better than score, the count is approximate when there are too many to count
+	CountDistinctBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, bool, error)
+
+	// Count get the number of members
+	Count(ctx context.Context, key string) (int64, error)
+
+	// Remove remove member and its metadata from the sorted sets of keys in one round trip
+	Remove(ctx context.Context, keys []string, member string) error
+
+	// Reset archive the standings of board as a new season and clear its entries and segments atomically,
+	// the seasons beyond the latest keep ones or ended before are pruned, the zero value means no limit
+	Reset(ctx context.Context, board *model.Board, segments []model.Segment, at time.Time, keep int64, before time.Time) (*model.ResetResult, error)
+
+	// Union store the union of the weighted sources into key by the aggregate function,
+	// the key expires after ttl, and the number of members stored is returned
+	Union(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error)
+
+	// Intersect store the intersection of the weighted sources into key by the aggregate function,
+	// the key expires after ttl, and the number of members stored is returned
+	Intersect(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error)
 
 	// SetExpire
 	SetExpire(ctx context.Context, key string, t time.Duration) error
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// NonceRepository Repository interface for the nonces of signed requests
+type NonceRepository interface {
+	// SaveNonce record the nonce of game for ttl, it returns false when the nonce has been recorded
+	SaveNonce(ctx context.Context, game, nonce string, ttl time.Duration) (bool, error)
+}
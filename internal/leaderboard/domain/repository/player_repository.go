@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// PlayerRepository Repository interface for player profiles
+type PlayerRepository interface {
+	// SaveProfile
+	SaveProfile(ctx context.Context, profile *model.Profile) error
+
+	// GetProfile
+	GetProfile(ctx context.Context, clientID string) (*model.Profile, error)
+
+	// GetProfiles get the profiles of clients, the clients without profile are not in the result
+	GetProfiles(ctx context.Context, clientIDs []string) (map[string]*model.Profile, error)
+
+	// SaveFriends replace the friends of client
+	SaveFriends(ctx context.Context, clientID string, friends []string) error
+
+	// GetFriends get the friends of client, it is empty when client has no friend
+	GetFriends(ctx context.Context, clientID string) ([]string, error)
+}
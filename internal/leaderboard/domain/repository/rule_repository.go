@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// RuleRepository Repository interface for the anti-cheat rules of board
+type RuleRepository interface {
+	// CountSubmission count the submission of client within the minute of at, and return the count of the minute
+	CountSubmission(ctx context.Context, board *model.Board, clientID string, at time.Time) (int64, error)
+
+	// Quarantine keep the rejected submission, only the latest max submissions are kept
+	Quarantine(ctx context.Context, board *model.Board, submission *model.Quarantined, max int64) error
+
+	// ListQuarantine list the quarantined submissions between 0-based start and stop index(inclusive), the latest first
+	ListQuarantine(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Quarantined, error)
+
+	// CountQuarantine get the number of quarantined submissions
+	CountQuarantine(ctx context.Context, board *model.Board) (int64, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// SeasonRepository Repository interface for the archived seasons of board
+type SeasonRepository interface {
+	// ListSeasons list the seasons of board, the latest first
+	ListSeasons(ctx context.Context, board *model.Board) ([]*model.Season, error)
+
+	// GetSeason
+	GetSeason(ctx context.Context, board *model.Board, id int64) (*model.Season, error)
+}
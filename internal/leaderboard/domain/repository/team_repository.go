@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// TeamRepository Repository interface for teams and their standings
+type TeamRepository interface {
+	// SaveTeam save the name of team, the members are kept
+	SaveTeam(ctx context.Context, team *model.Team) error
+
+	// GetTeam get team with its members
+	GetTeam(ctx context.Context, id string) (*model.Team, error)
+
+	// GetPlayerTeam get the team id of client, it is empty when client is not in any team
+	GetPlayerTeam(ctx context.Context, clientID string) (string, error)
+
+	// JoinTeam move client to team atomically, the team holds at most max members,
+	// and the previous team of client is returned, it is empty when client was not in any team
+	JoinTeam(ctx context.Context, team, clientID string, max int64) (string, error)
+
+	// LeaveTeam remove client from team
+	LeaveTeam(ctx context.Context, team, clientID string) error
+
+	// UpdateTeamScore recompute the score of team from the scores of its members on board,
+	// the team is removed from the standings when none of its members has score
+	UpdateTeamScore(ctx context.Context, board *model.Board, team string) error
+}
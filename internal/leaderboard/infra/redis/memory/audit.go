@@ -0,0 +1,243 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"leaderboard/internal/leaderboard/domain/model"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// auditKey - the stream key of the audit log
+const auditKey = "audit"
+
+// AppendAudit append the events to the stream of audit log in one pipeline, the stream is trimmed to about max events.
+// The event of player is indexed in the sorted set of player by its id, the ids trimmed from the stream are removed from the index,
+// and the index expires when the player has no event for the TTL of index
+func (r *Repo) AppendAudit(ctx context.Context, events []*model.AuditEvent, max int64) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var first *goredis.XMessageSliceCmd
+	cmds := make([]*goredis.StringCmd, len(events))
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, event := range events {
+			cmds[i] = pipe.XAdd(ctx, &goredis.XAddArgs{
+				Stream: auditKey,
+				MaxLen: max,
+				Approx: true,
+				Values: auditValues(event),
+			})
+		}
+
+		// the oldest event kept after trimming
+		first = pipe.XRangeN(ctx, auditKey, "-", "+", 1)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	oldest := "-"
+	if messages := first.Val(); len(messages) > 0 {
+		oldest = "(" + indexMember(messages[0].ID)
+	}
+
+	_, err = r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, event := range events {
+			if event.ClientID == "" {
+				continue
+			}
+
+			key := playerAuditKey(event.ClientID)
+			pipe.ZAdd(ctx, key, &goredis.Z{Member: indexMember(cmds[i].Val())})
+			pipe.ZRemRangeByLex(ctx, key, "-", oldest)
+			if r.auditIndexTTL > 0 {
+				pipe.Expire(ctx, key, r.auditIndexTTL)
+			}
+		}
+		return nil
+	})
+
+	return err
+}
+
+// ListAudit list the events of the stream between the ids of from and to in reverse, the id of stream starts with
+// the unix milliseconds appending the event, and the event of before is excluded
+func (r *Repo) ListAudit(ctx context.Context, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error) {
+	end := strconv.FormatInt(to.UnixMilli(), 10)
+	if before != "" {
+		end = "(" + before
+	}
+
+	messages, err := r.client.XRevRangeN(ctx, auditKey, end, strconv.FormatInt(from.UnixMilli(), 10), count).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEvents(messages)
+}
+
+// ListPlayerAudit list the events of player by the index of player in reverse, and read them from the stream
+// in one pipeline, the events trimmed from the stream are left out
+func (r *Repo) ListPlayerAudit(ctx context.Context, clientID string, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error) {
+	// the members of the milliseconds of to are before the prefix of the next millisecond
+	max := "(" + indexPrefix(to.UnixMilli()+1)
+	if before != "" {
+		max = "(" + indexMember(before)
+	}
+
+	members, err := r.client.ZRevRangeByLex(ctx, playerAuditKey(clientID), &goredis.ZRangeBy{
+		Max:   max,
+		Min:   "[" + indexPrefix(from.UnixMilli()),
+		Count: count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := make([]*goredis.XMessageSliceCmd, len(members))
+	if _, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, member := range members {
+			id := memberID(member)
+			cmds[i] = pipe.XRange(ctx, auditKey, id, id)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	messages := make([]goredis.XMessage, 0, len(members))
+	for _, cmd := range cmds {
+		messages = append(messages, cmd.Val()...)
+	}
+
+	return auditEvents(messages)
+}
+
+// indexMember - the member of the event id in the index of player, the milliseconds and the sequence of id are
+// zero-padded, so the members of equal score are ordered by the lexical order as the ids
+func indexMember(id string) string {
+	ms, seq := id, "0"
+	if i := strings.IndexByte(id, '-'); i >= 0 {
+		ms, seq = id[:i], id[i+1:]
+	}
+
+	t, _ := strconv.ParseUint(ms, 10, 64)
+	n, _ := strconv.ParseUint(seq, 10, 64)
+
+	return fmt.Sprintf("%020d-%020d", t, n)
+}
+
+// indexPrefix - the prefix of the members of the milliseconds, it is ordered before them
+func indexPrefix(ms int64) string {
+	return fmt.Sprintf("%020d-", ms)
+}
+
+// memberID - the event id of the member in the index of player
+func memberID(member string) string {
+	ms, seq := member, "0"
+	if i := strings.IndexByte(member, '-'); i >= 0 {
+		ms, seq = member[:i], member[i+1:]
+	}
+
+	t, _ := strconv.ParseUint(ms, 10, 64)
+	n, _ := strconv.ParseUint(seq, 10, 64)
+
+	return strconv.FormatUint(t, 10) + "-" + strconv.FormatUint(n, 10)
+}
+
+// playerAuditKey - the sorted set key of the ids of the events of player
+func playerAuditKey(clientID string) string {
+	return "player:" + clientID + ":audit"
+}
+
+// auditEvents - decode the events from the messages of stream
+func auditEvents(messages []goredis.XMessage) ([]*model.AuditEvent, error) {
+	result := make([]*model.AuditEvent, len(messages))
+	for i, m := range messages {
+		event, err := auditEvent(m)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = event
+	}
+
+	return result, nil
+}
+
+// auditValues - the fields of the event in the stream, the scores are left out when the player has no score
+func auditValues(event *model.AuditEvent) []interface{} {
+	values := []interface{}{
+		"action", string(event.Action),
+		"actor", event.Actor,
+		"ip", event.IP,
+		"requestId", event.RequestID,
+		"board", event.Board,
+		"clientId", event.ClientID,
+		"reason", event.Reason,
+		"createdAt", strconv.FormatInt(event.CreatedAt, 10),
+	}
+
+	if event.Previous != nil {
+		values = append(values, "previous", formatFloat(*event.Previous))
+	}
+	if event.Score != nil {
+		values = append(values, "score", formatFloat(*event.Score))
+	}
+
+	return values
+}
+
+// auditEvent - decode the event from the message of stream
+func auditEvent(m goredis.XMessage) (*model.AuditEvent, error) {
+	field := func(name string) string {
+		v, _ := m.Values[name].(string)
+		return v
+	}
+
+	event := &model.AuditEvent{
+		ID:        m.ID,
+		Action:    model.AuditAction(field("action")),
+		Actor:     field("actor"),
+		IP:        field("ip"),
+		RequestID: field("requestId"),
+		Board:     field("board"),
+		ClientID:  field("clientId"),
+		Reason:    field("reason"),
+	}
+
+	createdAt, err := strconv.ParseInt(field("createdAt"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	event.CreatedAt = createdAt
+
+	if event.Previous, err = optionalFloat(field("previous")); err != nil {
+		return nil, err
+	}
+
+	if event.Score, err = optionalFloat(field("score")); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// optionalFloat - parse the float, nil when it is empty
+func optionalFloat(v string) (*float64, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
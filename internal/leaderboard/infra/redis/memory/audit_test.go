@@ -0,0 +1,327 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// Test_AppendAudit
+func (t *TestSuite) Test_AppendAudit() {
+	previous, score := float64(900), float64(500)
+
+	tests := []struct {
+		name   string
+		events []*model.AuditEvent
+		values [][]interface{}
+
+		// indexed the players whose events are indexed
+		indexed []string
+	}{
+		{
+			name: "test append adjustment and ban case",
+			events: []*model.AuditEvent{
+				{
+					Action:    model.AuditAdjust,
+					Actor:     "ops",
+					IP:        "10.0.0.1",
+					RequestID: "req-1",
+					Board:     "default",
+					ClientID:  "adam",
+					Previous:  &previous,
+					Score:     &score,
+					Reason:    "refund",
+					CreatedAt: 1760000000,
+				},
+				{
+					Action:    model.AuditBan,
+					Actor:     "ops",
+					ClientID:  "adam",
+					Reason:    "speed hack",
+					CreatedAt: 1760000000,
+				},
+			},
+			values: [][]interface{}{
+				{
+					"action", "adjust",
+					"actor", "ops",
+					"ip", "10.0.0.1",
+					"requestId", "req-1",
+					"board", "default",
+					"clientId", "adam",
+					"reason", "refund",
+					"createdAt", "1760000000",
+					"previous", "900",
+					"score", "500",
+				},
+				{
+					"action", "ban",
+					"actor", "ops",
+					"ip", "",
+					"requestId", "",
+					"board", "",
+					"clientId", "adam",
+					"reason", "speed hack",
+					"createdAt", "1760000000",
+				},
+			},
+			indexed: []string{"adam", "adam"},
+		},
+		{
+			name: "test append reset case",
+			events: []*model.AuditEvent{
+				{
+					Action:    model.AuditReset,
+					Actor:     "system",
+					Board:     "weekly",
+					CreatedAt: 1760000000,
+				},
+			},
+			values: [][]interface{}{
+				{
+					"action", "reset",
+					"actor", "system",
+					"ip", "",
+					"requestId", "",
+					"board", "weekly",
+					"clientId", "",
+					"reason", "",
+					"createdAt", "1760000000",
+				},
+			},
+		},
+	}
+
+	t.Repo.auditIndexTTL = time.Hour
+	defer func() { t.Repo.auditIndexTTL = 0 }()
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			for i, values := range test.values {
+				t.mockClient.ExpectXAdd(&goredis.XAddArgs{
+					Stream: auditKey,
+					MaxLen: 1000,
+					Approx: true,
+					Values: values,
+				}).SetVal(fmt.Sprintf("1760000000000-%d", i))
+			}
+			// the events before it are trimmed from the stream
+			t.mockClient.ExpectXRangeN(auditKey, "-", "+", 1).SetVal([]goredis.XMessage{{ID: "1759990000000-12"}})
+			for i, id := range test.indexed {
+				t.mockClient.ExpectZAdd("player:"+id+":audit", &goredis.Z{
+					Member: fmt.Sprintf("00000001760000000000-%020d", i),
+				}).SetVal(1)
+				t.mockClient.ExpectZRemRangeByLex("player:"+id+":audit", "-", "(00000001759990000000-00000000000000000012").SetVal(0)
+				t.mockClient.ExpectExpire("player:"+id+":audit", time.Hour).SetVal(true)
+			}
+
+			t.NoError(t.Repo.AppendAudit(context.Background(), test.events, 1000))
+			t.NoError(t.mockClient.ExpectationsWereMet())
+			t.mockClient.ClearExpect()
+		})
+	}
+
+	t.NoError(t.Repo.AppendAudit(context.Background(), nil, 1000))
+}
+
+// Test_ListAudit
+func (t *TestSuite) Test_ListAudit() {
+	from, to := time.UnixMilli(1760000000000), time.UnixMilli(1760003600999)
+	previous, score := float64(900), float64(500)
+
+	messages := []goredis.XMessage{
+		{
+			ID: "1760000500000-1",
+			Values: map[string]interface{}{
+				"action":    "adjust",
+				"actor":     "ops",
+				"ip":        "10.0.0.1",
+				"requestId": "req-1",
+				"board":     "default",
+				"clientId":  "adam",
+				"reason":    "refund",
+				"createdAt": "1760000500",
+				"previous":  "900",
+				"score":     "500",
+			},
+		},
+		{
+			ID: "1760000400000-0",
+			Values: map[string]interface{}{
+				"action":    "reset",
+				"actor":     "system",
+				"ip":        "",
+				"requestId": "",
+				"board":     "weekly",
+				"clientId":  "",
+				"reason":    "",
+				"createdAt": "1760000400",
+			},
+		},
+	}
+
+	events := []*model.AuditEvent{
+		{
+			ID:        "1760000500000-1",
+			Action:    model.AuditAdjust,
+			Actor:     "ops",
+			IP:        "10.0.0.1",
+			RequestID: "req-1",
+			Board:     "default",
+			ClientID:  "adam",
+			Previous:  &previous,
+			Score:     &score,
+			Reason:    "refund",
+			CreatedAt: 1760000500,
+		},
+		{
+			ID:        "1760000400000-0",
+			Action:    model.AuditReset,
+			Actor:     "system",
+			Board:     "weekly",
+			CreatedAt: 1760000400,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		before     string
+		fn         func()
+		wantResult []*model.AuditEvent
+		wantError  error
+	}{
+		{
+			name: "test list audit case",
+			fn: func() {
+				t.mockClient.ExpectXRevRangeN(auditKey, "1760003600999", "1760000000000", 100).SetVal(messages)
+			},
+			wantResult: events,
+		},
+		{
+			name:   "test list audit before event case",
+			before: "1760000600000-0",
+			fn: func() {
+				t.mockClient.ExpectXRevRangeN(auditKey, "(1760000600000-0", "1760000000000", 100).SetVal(messages[1:])
+			},
+			wantResult: events[1:],
+		},
+		{
+			name: "test list audit failed case",
+			fn: func() {
+				t.mockClient.ExpectXRevRangeN(auditKey, "1760003600999", "1760000000000", 100).SetErr(errors.New("failed"))
+			},
+			wantError: errors.New("failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.ListAudit(context.Background(), from, to, test.before, 100)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_ListPlayerAudit
+func (t *TestSuite) Test_ListPlayerAudit() {
+	from, to := time.UnixMilli(1760000000000), time.UnixMilli(1760003600999)
+
+	message := goredis.XMessage{
+		ID: "1760000500000-1",
+		Values: map[string]interface{}{
+			"action":    "ban",
+			"actor":     "ops",
+			"clientId":  "adam",
+			"reason":    "speed hack",
+			"createdAt": "1760000500",
+		},
+	}
+	event := &model.AuditEvent{
+		ID:        "1760000500000-1",
+		Action:    model.AuditBan,
+		Actor:     "ops",
+		ClientID:  "adam",
+		Reason:    "speed hack",
+		CreatedAt: 1760000500,
+	}
+
+	tests := []struct {
+		name       string
+		before     string
+		fn         func()
+		wantResult []*model.AuditEvent
+		wantError  error
+	}{
+		{
+			name: "test list audit of player case",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeByLex("player:adam:audit", &goredis.ZRangeBy{
+					Max:   "(00000001760003601000-",
+					Min:   "[00000001760000000000-",
+					Count: 10,
+				}).SetVal([]string{"00000001760000500000-00000000000000000001", "00000001760000400000-00000000000000000000"})
+				t.mockClient.ExpectXRange(auditKey, "1760000500000-1", "1760000500000-1").SetVal([]goredis.XMessage{message})
+				// the event trimmed from the stream is left out
+				t.mockClient.ExpectXRange(auditKey, "1760000400000-0", "1760000400000-0").SetVal([]goredis.XMessage{})
+			},
+			wantResult: []*model.AuditEvent{event},
+		},
+		{
+			name:   "test list audit of player before event case",
+			before: "1760000600000-2",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeByLex("player:adam:audit", &goredis.ZRangeBy{
+					Max:   "(00000001760000600000-00000000000000000002",
+					Min:   "[00000001760000000000-",
+					Count: 10,
+				}).SetVal([]string{})
+			},
+			wantResult: []*model.AuditEvent{},
+		},
+		{
+			name:   "test list audit of player before event beyond 999 of one millisecond case",
+			before: "1760000500000-1200",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeByLex("player:adam:audit", &goredis.ZRangeBy{
+					Max:   "(00000001760000500000-00000000000000001200",
+					Min:   "[00000001760000000000-",
+					Count: 10,
+				}).SetVal([]string{"00000001760000500000-00000000000000001100"})
+				t.mockClient.ExpectXRange(auditKey, "1760000500000-1100", "1760000500000-1100").SetVal([]goredis.XMessage{message})
+			},
+			wantResult: []*model.AuditEvent{event},
+		},
+		{
+			name: "test list audit of player failed case",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeByLex("player:adam:audit", &goredis.ZRangeBy{
+					Max:   "(00000001760003601000-",
+					Min:   "[00000001760000000000-",
+					Count: 10,
+				}).SetErr(errors.New("failed"))
+			},
+			wantError: errors.New("failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.ListPlayerAudit(context.Background(), "adam", from, to, test.before, 10)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+			t.mockClient.ClearExpect()
+		})
+	}
+}
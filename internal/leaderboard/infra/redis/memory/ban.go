@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/pkg/encoder/json"
+)
+
+// banKey - the hash key of the bans by client id
+const banKey = "bans"
+
+// SaveBan save the ban in the hash of bans by client id
+func (r *Repo) SaveBan(ctx context.Context, ban *model.Ban) error {
+	if ban.ClientID == "" {
+		return ErrEmptyMember
+	}
+
+	value, err := json.NewEncoder().Encode(ban)
+	if err != nil {
+		return err
+	}
+
+	return r.client.HSet(ctx, banKey, ban.ClientID, value).Err()
+}
+
+// DeleteBan delete the ban of client
+func (r *Repo) DeleteBan(ctx context.Context, clientID string) error {
+	deleted, err := r.client.HDel(ctx, banKey, clientID).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return model.ErrBanNotFound
+	}
+
+	return nil
+}
+
+// Banned get the banned clients among clientIDs in one round trip
+func (r *Repo) Banned(ctx context.Context, clientIDs []string) (map[string]bool, error) {
+	result := map[string]bool{}
+	if len(clientIDs) == 0 {
+		return result, nil
+	}
+
+	values, err := r.client.HMGet(ctx, banKey, clientIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range values {
+		if v != nil {
+			result[clientIDs[i]] = true
+		}
+	}
+
+	return result, nil
+}
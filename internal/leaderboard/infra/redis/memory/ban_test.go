@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// Test_SaveBan
+func (t *TestSuite) Test_SaveBan() {
+	ban := &model.Ban{ClientID: "adam", Reason: "speed hack", Actor: "ops", CreatedAt: 1760000000}
+
+	t.mockClient.ExpectHSet(banKey, "adam", []byte(`{"clientId":"adam","reason":"speed hack","actor":"ops","createdAt":1760000000}`)).SetVal(1)
+
+	t.NoError(t.Repo.SaveBan(context.Background(), ban))
+	t.Equal(ErrEmptyMember, t.Repo.SaveBan(context.Background(), &model.Ban{}))
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_DeleteBan
+func (t *TestSuite) Test_DeleteBan() {
+	tests := []struct {
+		name      string
+		fn        func()
+		wantError error
+	}{
+		{
+			name: "test delete ban case",
+			fn: func() {
+				t.mockClient.ExpectHDel(banKey, "adam").SetVal(1)
+			},
+		},
+		{
+			name: "test delete ban not found case",
+			fn: func() {
+				t.mockClient.ExpectHDel(banKey, "adam").SetVal(0)
+			},
+			wantError: model.ErrBanNotFound,
+		},
+		{
+			name: "test delete ban error case",
+			fn: func() {
+				t.mockClient.ExpectHDel(banKey, "adam").SetErr(errors.New("error"))
+			},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			err := t.Repo.DeleteBan(context.Background(), "adam")
+			t.Equal(test.wantError, err)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Banned
+func (t *TestSuite) Test_Banned() {
+	t.mockClient.ExpectHMGet(banKey, "adam", "bob", "mary").SetVal([]interface{}{nil, `{"clientId":"bob"}`, nil})
+
+	got, err := t.Repo.Banned(context.Background(), []string{"adam", "bob", "mary"})
+	t.NoError(err)
+	t.Equal(map[string]bool{"bob": true}, got)
+
+	// nothing is read without clients
+	got, err = t.Repo.Banned(context.Background(), nil)
+	t.NoError(err)
+	t.Equal(map[string]bool{}, got)
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/pkg/encoder/json"
+	"sort"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+const (
+	// boardsKey - the set of all board ids
+	boardsKey = "boards"
+)
+
+// SaveBoard save board metadata and add it to the board index
+func (r *Repo) SaveBoard(ctx context.Context, board *model.Board) error {
+	b, err := json.NewEncoder().Encode(board)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, board.MetaKey(), b, 0)
+		pipe.SAdd(ctx, boardsKey, board.ID)
+		return nil
+	})
+
+	return err
+}
+
+// GetBoard get board metadata
+func (r *Repo) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	board := &model.Board{ID: id}
+
+	b, err := r.client.Get(ctx, board.MetaKey()).Bytes()
+	if err == goredis.Nil {
+		return nil, model.ErrBoardNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.NewEncoder().Decode(b, board); err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+// ListBoards list all boards order by id
+func (r *Repo) ListBoards(ctx context.Context) ([]*model.Board, error) {
+	ids, err := r.client.SMembers(ctx, boardsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*model.Board{}, nil
+	}
+
+	sort.Strings(ids)
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = (&model.Board{ID: id}).MetaKey()
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	coder := json.NewEncoder()
+	result := make([]*model.Board, 0, len(values))
+
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		board := &model.Board{}
+		if err := coder.Decode([]byte(s), board); err != nil {
+			return nil, err
+		}
+
+		result = append(result, board)
+	}
+
+	return result, nil
+}
+
+// DeleteBoard delete board metadata, its sorted set with score metadata, entries, seasons, team standings,
+// quarantined submissions, segments and the buckets of windows kept at the time, so a board created again with the id starts empty
+func (r *Repo) DeleteBoard(ctx context.Context, id string, segments []model.Segment, windows []model.Window, at time.Time, keep int) error {
+	board := &model.Board{ID: id}
+
+	seasons, err := r.client.ZRange(ctx, board.SeasonsKey(), 0, -1).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := []string{
+		board.MetaKey(), board.Key(), dataKey(board.Key()),
+		board.EntriesKey(), board.EntryDataKey(), board.EntrySeqKey(),
+		board.SeasonsKey(), board.SeasonSeqKey(), board.TeamsKey(), board.QuarantineKey(),
+	}
+	for _, season := range seasons {
+		key := board.SeasonKeyPrefix() + season
+		keys = append(keys, key, dataKey(key))
+	}
+	for _, s := range segments {
+		key := board.SegmentKey(s)
+		keys = append(keys, key, dataKey(key))
+	}
+	for _, w := range windows {
+		for _, bucket := range w.Buckets(at, keep) {
+			key := board.WindowKey(w, bucket)
+			keys = append(keys, key, dataKey(key))
+		}
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.SRem(ctx, boardsKey, id)
+		return nil
+	})
+
+	return err
+}
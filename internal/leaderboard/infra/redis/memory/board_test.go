@@ -0,0 +1,261 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// Test_SaveBoard
+func (t *TestSuite) Test_SaveBoard() {
+	type args struct {
+		ctx   context.Context
+		board *model.Board
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(args)
+		args      args
+		wantError bool
+	}{
+		{
+			name: "test save board success case",
+			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
+				t.mockClient.ExpectSet(in.board.MetaKey(), []byte(`{"id":"racing","name":"Racing","order":"asc","reset":"never","update":"min","tieBreak":"first","rankMode":"dense"}`), 0).SetVal("OK")
+				t.mockClient.ExpectSAdd(boardsKey, in.board.ID).SetVal(1)
+				t.mockClient.ExpectTxPipelineExec()
+			},
+			args: args{
+				ctx: context.Background(),
+				board: &model.Board{
+					ID:       "racing",
+					Name:     "Racing",
+					Order:    model.OrderAsc,
+					Reset:    model.ResetNever,
+					Update:   model.UpdateMin,
+					TieBreak: model.TieBreakFirst,
+					RankMode: model.RankDense,
+				},
+			},
+			wantError: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			err := t.Repo.SaveBoard(test.args.ctx, test.args.board)
+			t.Equal(test.wantError, err != nil)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_GetBoard
+func (t *TestSuite) Test_GetBoard() {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Board
+		wantError  error
+	}{
+		{
+			name: "test get board success case",
+			fn: func(in args) {
+				t.mockClient.ExpectGet("board:racing:meta").SetVal(`{"id":"racing","name":"Racing","order":"asc","reset":"never"}`)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantResult: &model.Board{
+				ID:    "racing",
+				Name:  "Racing",
+				Order: model.OrderAsc,
+				Reset: model.ResetNever,
+			},
+		},
+		{
+			name: "test board not found case",
+			fn: func(in args) {
+				t.mockClient.ExpectGet("board:racing:meta").RedisNil()
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: model.ErrBoardNotFound,
+		},
+		{
+			name: "test get board error case",
+			fn: func(in args) {
+				t.mockClient.ExpectGet("board:racing:meta").SetErr(errors.New("error"))
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.GetBoard(test.args.ctx, test.args.id)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_ListBoards
+func (t *TestSuite) Test_ListBoards() {
+	type args struct {
+		ctx context.Context
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult []*model.Board
+		wantError  bool
+	}{
+		{
+			name: "test list boards success case",
+			fn: func(in args) {
+				t.mockClient.ExpectSMembers(boardsKey).SetVal([]string{"racing", "default", "removed"})
+				t.mockClient.ExpectMGet("board:default:meta", "board:racing:meta", "board:removed:meta").SetVal([]interface{}{
+					`{"id":"default","name":"Leaderboard","order":"desc","reset":"ttl"}`,
+					`{"id":"racing","name":"Racing","order":"asc","reset":"never"}`,
+					nil,
+				})
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantResult: []*model.Board{
+				{
+					ID:    "default",
+					Name:  "Leaderboard",
+					Order: model.OrderDesc,
+					Reset: model.ResetTTL,
+				},
+				{
+					ID:    "racing",
+					Name:  "Racing",
+					Order: model.OrderAsc,
+					Reset: model.ResetNever,
+				},
+			},
+		},
+		{
+			name: "test list empty boards case",
+			fn: func(in args) {
+				t.mockClient.ExpectSMembers(boardsKey).SetVal([]string{})
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantResult: []*model.Board{},
+		},
+		{
+			name: "test list boards error case",
+			fn: func(in args) {
+				t.mockClient.ExpectSMembers(boardsKey).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.ListBoards(test.args.ctx)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_DeleteBoard
+func (t *TestSuite) Test_DeleteBoard() {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(args)
+		args      args
+		wantError bool
+	}{
+		{
+			name: "test delete board success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRange("board:racing:seasons", 0, -1).SetVal([]string{"1", "2"})
+				t.mockClient.ExpectTxPipeline()
+				t.mockClient.ExpectDel("board:racing:meta", "board:racing", "board:racing:data", "board:racing:entries", "board:racing:entries:data", "board:racing:entries:seq",
+					"board:racing:seasons", "board:racing:seasons:seq", "board:racing:teams", "board:racing:quarantine", "board:racing:season:1", "board:racing:season:1:data", "board:racing:season:2", "board:racing:season:2:data",
+					"board:racing:segment:country:TW", "board:racing:segment:country:TW:data",
+					"board:racing:daily:2026-10-18", "board:racing:daily:2026-10-18:data", "board:racing:daily:2026-10-17", "board:racing:daily:2026-10-17:data",
+					"board:racing:monthly:2026-10", "board:racing:monthly:2026-10:data", "board:racing:monthly:2026-09", "board:racing:monthly:2026-09:data").SetVal(5)
+				t.mockClient.ExpectSRem(boardsKey, in.id).SetVal(1)
+				t.mockClient.ExpectTxPipelineExec()
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: false,
+		},
+		{
+			name: "test delete board list seasons error case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRange("board:racing:seasons", 0, -1).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			// the latest 2 buckets of each window are deleted
+			at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+			err := t.Repo.DeleteBoard(test.args.ctx, test.args.id, []model.Segment{{Name: "country", Value: "TW"}}, []model.Window{model.WindowDaily, model.WindowMonthly}, at, 2)
+			t.Equal(test.wantError, err != nil)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
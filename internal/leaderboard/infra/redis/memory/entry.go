@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/pkg/encoder/json"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// CreateEntry record the submission as a new entry, the entry id is generated by the board sequence.
+// The entries expire after TTL without new entry when TTL is set
+func (r *Repo) CreateEntry(ctx context.Context, board *model.Board, in *model.Entry, ttl time.Duration) (*model.Entry, error) {
+	if in.ClientID == "" {
+		return nil, ErrEmptyMember
+	}
+
+	if in.CreatedAt == 0 {
+		in.CreatedAt = time.Now().Unix()
+	}
+
+	score := in.Score
+	if board.TieBreakEnabled() {
+		score += encodeFraction(board, in.CreatedAt)
+	}
+
+	meta, err := json.NewEncoder().Encode(&model.Entry{
+		ClientID:  in.ClientID,
+		Score:     in.Score,
+		CreatedAt: in.CreatedAt,
+		Metadata:  in.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{board.EntriesKey(), board.EntryDataKey(), board.EntrySeqKey()}
+
+	id, err := entryScript.Run(ctx, r.client, keys, score, string(meta), int64(ttl/time.Second)).Text()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Entry{
+		EntryID:   id,
+		ClientID:  in.ClientID,
+		Score:     in.Score,
+		CreatedAt: in.CreatedAt,
+		Metadata:  in.Metadata,
+	}, nil
+}
+
+// ListEntries list entries between 0-based start and stop index(inclusive) with rank by the order of board
+func (r *Repo) ListEntries(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Entry, error) {
+	var (
+		scores []goredis.Z
+		err    error
+	)
+
+	key := board.EntriesKey()
+	if board.Order == model.OrderAsc {
+		scores, err = r.client.ZRangeWithScores(ctx, key, start, stop).Result()
+	} else {
+		scores, err = r.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Entry, len(scores))
+	if len(scores) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(scores))
+	for i, z := range scores {
+		ids[i] = z.Member.(string)
+	}
+
+	values, err := r.client.HMGet(ctx, board.EntryDataKey(), ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	coder := json.NewEncoder()
+	for i, z := range scores {
+		entry := &model.Entry{}
+
+		// the metadata is written with the entry atomically, so it only misses when the hash is broken
+		if s, ok := values[i].(string); ok {
+			if err := coder.Decode([]byte(s), entry); err != nil {
+				return nil, err
+			}
+		}
+
+		entry.EntryID = ids[i]
+		entry.Score = decodeScore(board, z.Score)
+		entry.Rank = start + int64(i) + 1
+
+		result[i] = entry
+	}
+
+	return result, nil
+}
+
+// CountEntries get the number of entries
+func (r *Repo) CountEntries(ctx context.Context, board *model.Board) (int64, error) {
+	return r.client.ZCard(ctx, board.EntriesKey()).Result()
+}
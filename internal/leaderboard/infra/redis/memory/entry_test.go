@@ -0,0 +1,226 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// Test_CreateEntry
+func (t *TestSuite) Test_CreateEntry() {
+	type args struct {
+		ctx   context.Context
+		board *model.Board
+		entry *model.Entry
+		ttl   time.Duration
+	}
+
+	board := &model.Board{ID: "default"}
+	keys := []string{"board:default:entries", "board:default:entries:data", "board:default:entries:seq"}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Entry
+		wantError  bool
+	}{
+		{
+			name: "test create entry success case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(entryScript.Hash(), keys, float64(10.5), `{"clientId":"adam","score":10.5,"createdAt":1664553600,"metadata":{"level":3}}`, int64(600)).
+					SetVal("12")
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: board,
+				entry: &model.Entry{
+					ClientID:  "adam",
+					Score:     10.5,
+					CreatedAt: 1664553600,
+					Metadata:  json.RawMessage(`{"level":3}`),
+				},
+				ttl: time.Minute * 10,
+			},
+			wantResult: &model.Entry{
+				EntryID:   "12",
+				ClientID:  "adam",
+				Score:     10.5,
+				CreatedAt: 1664553600,
+				Metadata:  json.RawMessage(`{"level":3}`),
+			},
+		},
+		{
+			name: "test create entry with tie-break case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(entryScript.Hash(), []string{"board:arena:entries", "board:arena:entries:data", "board:arena:entries:seq"},
+					float64(10.25), `{"clientId":"adam","score":10,"createdAt":67109864}`, int64(0)).
+					SetVal("1")
+			},
+			args: args{
+				ctx: context.Background(),
+				board: &model.Board{
+					ID:        "arena",
+					TieBreak:  model.TieBreakLast,
+					CreatedAt: 1000,
+				},
+				entry: &model.Entry{
+					ClientID:  "adam",
+					Score:     10,
+					CreatedAt: 1000 + tieScale/4,
+				},
+			},
+			wantResult: &model.Entry{
+				EntryID:   "1",
+				ClientID:  "adam",
+				Score:     10,
+				CreatedAt: 1000 + tieScale/4,
+			},
+		},
+		{
+			name: "test create entry error case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(entryScript.Hash(), keys, float64(1), `{"clientId":"adam","score":1,"createdAt":1664553600}`, int64(0)).
+					SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: board,
+				entry: &model.Entry{
+					ClientID:  "adam",
+					Score:     1,
+					CreatedAt: 1664553600,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test member is empty",
+			fn:   func(in args) {},
+			args: args{
+				ctx:   context.Background(),
+				board: board,
+				entry: &model.Entry{
+					Score: 1,
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.CreateEntry(test.args.ctx, test.args.board, test.args.entry, test.args.ttl)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_ListEntries
+func (t *TestSuite) Test_ListEntries() {
+	type args struct {
+		ctx   context.Context
+		board *model.Board
+		start int64
+		stop  int64
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult []*model.Entry
+		wantError  bool
+	}{
+		{
+			name: "test list entries success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRevRangeWithScores(in.board.EntriesKey(), in.start, in.stop).SetVal([]goredis.Z{
+					{Member: "3", Score: 100},
+					{Member: "1", Score: 90},
+				})
+				t.mockClient.ExpectHMGet(in.board.EntryDataKey(), "3", "1").SetVal([]interface{}{
+					`{"clientId":"adam","score":100,"createdAt":3,"metadata":{"replayId":"r-3"}}`,
+					`{"clientId":"adam","score":90,"createdAt":1}`,
+				})
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: &model.Board{ID: "default", Order: model.OrderDesc},
+				start: 0,
+				stop:  1,
+			},
+			wantResult: []*model.Entry{
+				{EntryID: "3", ClientID: "adam", Score: 100, CreatedAt: 3, Metadata: json.RawMessage(`{"replayId":"r-3"}`), Rank: 1},
+				{EntryID: "1", ClientID: "adam", Score: 90, CreatedAt: 1, Rank: 2},
+			},
+		},
+		{
+			name: "test list entries of ascending board case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRangeWithScores(in.board.EntriesKey(), in.start, in.stop).SetVal([]goredis.Z{
+					{Member: "2", Score: 61.2},
+				})
+				t.mockClient.ExpectHMGet(in.board.EntryDataKey(), "2").SetVal([]interface{}{
+					`{"clientId":"peter","score":61.2,"createdAt":2}`,
+				})
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: &model.Board{ID: "racing", Order: model.OrderAsc},
+				start: 4,
+				stop:  5,
+			},
+			wantResult: []*model.Entry{
+				{EntryID: "2", ClientID: "peter", Score: 61.2, CreatedAt: 2, Rank: 5},
+			},
+		},
+		{
+			name: "test list empty entries case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRevRangeWithScores(in.board.EntriesKey(), in.start, in.stop).SetVal([]goredis.Z{})
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: &model.Board{ID: "default"},
+				start: 0,
+				stop:  9,
+			},
+			wantResult: []*model.Entry{},
+		},
+		{
+			name: "test list entries error case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRevRangeWithScores(in.board.EntriesKey(), in.start, in.stop).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: &model.Board{ID: "default"},
+				start: 0,
+				stop:  9,
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.ListEntries(test.args.ctx, test.args.board, test.args.start, test.args.stop)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
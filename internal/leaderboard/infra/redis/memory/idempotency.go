@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// idempotencyKeyPrefix - the prefix of idempotency keys, followed by the scoped key
+const idempotencyKeyPrefix = "idempotency:"
+
+// Reserve record the request in progress when key is not used, the check and write are done in one Lua script
+func (r *Repo) Reserve(ctx context.Context, key string, request *model.IdempotentRequest, ttl time.Duration) (*model.IdempotentRequest, error) {
+	value, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := reserveScript.Run(ctx, r.client, []string{idempotencyKey(key)}, string(value), ttl.Milliseconds()).Text()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := &model.IdempotentRequest{}
+	if err := json.Unmarshal([]byte(recorded), out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Complete replace the request in progress with the completed one
+func (r *Repo) Complete(ctx context.Context, key string, request *model.IdempotentRequest, ttl time.Duration) error {
+	value, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Set(ctx, idempotencyKey(key), value, ttl).Err()
+}
+
+// Release delete key
+func (r *Repo) Release(ctx context.Context, key string) error {
+	return r.client.Del(ctx, idempotencyKey(key)).Err()
+}
+
+// idempotencyKey - the string key of the request
+func idempotencyKey(key string) string {
+	return idempotencyKeyPrefix + key
+}
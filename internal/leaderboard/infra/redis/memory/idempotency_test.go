@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// Test_Reserve
+func (t *TestSuite) Test_Reserve() {
+	pending := `{"fingerprint":"f1","done":false}`
+
+	tests := []struct {
+		name       string
+		fn         func()
+		wantResult *model.IdempotentRequest
+		wantError  bool
+	}{
+		{
+			name: "test reserve key case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(reserveScript.Hash(), []string{"idempotency:adam:k1"}, pending, int64(60000)).RedisNil()
+			},
+		},
+		{
+			name: "test key used by completed request case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(reserveScript.Hash(), []string{"idempotency:adam:k1"}, pending, int64(60000)).
+					SetVal(`{"fingerprint":"f1","done":true,"result":{"score":10}}`)
+			},
+			wantResult: &model.IdempotentRequest{
+				Fingerprint: "f1",
+				Done:        true,
+				Result:      json.RawMessage(`{"score":10}`),
+			},
+		},
+		{
+			name: "test reserve error case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(reserveScript.Hash(), []string{"idempotency:adam:k1"}, pending, int64(60000)).SetErr(errors.New(""))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.Reserve(context.Background(), "adam:k1", &model.IdempotentRequest{Fingerprint: "f1"}, time.Minute)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Complete
+func (t *TestSuite) Test_Complete() {
+	request := &model.IdempotentRequest{
+		Fingerprint: "f1",
+		Done:        true,
+		Result:      json.RawMessage(`{"score":10}`),
+	}
+	value, _ := json.Marshal(request)
+
+	t.mockClient.ExpectSet("idempotency:adam:k1", value, time.Minute).SetVal("OK")
+	t.NoError(t.Repo.Complete(context.Background(), "adam:k1", request, time.Minute))
+
+	t.mockClient.ExpectDel("idempotency:adam:k1").SetVal(1)
+	t.NoError(t.Repo.Release(context.Background(), "adam:k1"))
+
+	t.NoError(t.mockClient.ExpectationsWereMet())
+	t.mockClient.ClearExpect()
+}
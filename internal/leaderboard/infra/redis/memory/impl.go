@@ -2,59 +2,427 @@ package memory
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"leaderboard/internal/leaderboard/domain/model"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
 )
 
-// Create -
-func (r *Repo) Create(ctx context.Context, key string, in *model.Score) error {
-	return r.client.ZAdd(ctx, key, &goredis.Z{
-		Score:  float64(in.Score),
-		Member: in.ClientID,
-	}).Err()
+var (
+	// ErrEmptyMember -
+	ErrEmptyMember = errors.New("member is empty")
+
+	// ErrUnexpectedReply -
+	ErrUnexpectedReply = errors.New("unexpected reply from redis")
+)
+
+// Create record score by the update policy of board, and return the stored score.
+// The score is encoded with the time reaching it when tie-break of board is enabled,
+// and the metadata is stored in the hash next to the sorted set
+func (r *Repo) Create(ctx context.Context, key string, in *model.Score, board *model.Board) (*model.ScoreResult, error) {
+	if in.ClientID == "" {
+		return nil, ErrEmptyMember
+	}
+
+	keys := []string{key, dataKey(key)}
+
+	res, err := createScript.Run(ctx, r.client, keys, createArgs(in, board)...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return scoreResult(in.ClientID, res, board)
 }
 
-// List
-func (r *Repo) List(ctx context.Context, key string, offset, limit int64) ([]*model.Score, error) {
-	scores, err := r.client.ZRevRangeWithScores(ctx, key, offset, limit).Result()
+// CreateBatch record the scores by the update policy of board in one pipeline, and return the stored scores
+// in the order of the submissions, the submissions of the same member are recorded one by one
+func (r *Repo) CreateBatch(ctx context.Context, key string, in []*model.Score, board *model.Board) ([]*model.ScoreResult, error) {
+	for _, s := range in {
+		if s.ClientID == "" {
+			return nil, ErrEmptyMember
+		}
+	}
+
+	keys := []string{key, dataKey(key)}
+
+	run := func() ([]*goredis.Cmd, error) {
+		cmds := make([]*goredis.Cmd, len(in))
+		_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+			for i, s := range in {
+				cmds[i] = createScript.EvalSha(ctx, pipe, keys, createArgs(s, board)...)
+			}
+			return nil
+		})
+		return cmds, err
+	}
+
+	cmds, err := run()
+
+	// the script is not cached by redis yet, load it and run the pipeline again
+	if err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT ") {
+		if err := createScript.Load(ctx, r.client).Err(); err != nil {
+			return nil, err
+		}
+		cmds, err = run()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.ScoreResult, len(in))
+	for i, cmd := range cmds {
+		res, err := cmd.Slice()
+		if err != nil {
+			return nil, err
+		}
+
+		if results[i], err = scoreResult(in[i].ClientID, res, board); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// createArgs the arguments of createScript
+func createArgs(in *model.Score, board *model.Board) []interface{} {
+	fraction := ""
+	if board.TieBreakEnabled() {
+		at := in.CreatedAt
+		if at == 0 {
+			at = time.Now().Unix()
+		}
+		fraction = formatFloat(encodeFraction(board, at))
+	}
+
+	return []interface{}{in.ClientID, in.Score, string(board.Update), fraction, string(in.Metadata), model.MaxTieBreakScore}
+}
+
+// scoreResult decode the reply of createScript
+func scoreResult(clientID string, res []interface{}, board *model.Board) (*model.ScoreResult, error) {
+	if len(res) == 3 {
+		return nil, model.ErrScoreOutOfRange
+	}
+
+	if len(res) != 2 {
+		return nil, ErrUnexpectedReply
+	}
+
+	stored, _ := res[1].(string)
+
+	score, err := strconv.ParseFloat(stored, 64)
+	if err != nil {
+		return nil, err
+	}
+	score = decodeScore(board, score)
+
+	result := &model.ScoreResult{
+		ClientID: clientID,
+		Score:    score,
+		Changed:  true,
+	}
+
+	// the previous score is nil when member is new
+	if previous, ok := res[0].(string); ok {
+		old, err := strconv.ParseFloat(previous, 64)
+		if err != nil {
+			return nil, err
+		}
+		old = decodeScore(board, old)
+		result.Previous, result.Changed = &old, old != score
+	}
+
+	return result, nil
+}
+
+// List list members between 0-based start and stop index(inclusive) with rank by the order of board
+func (r *Repo) List(ctx context.Context, key string, start, stop int64, board *model.Board) ([]*model.Score, error) {
+	var (
+		scores []goredis.Z
+		err    error
+	)
+
+	if board.Order == model.OrderAsc {
+		scores, err = r.client.ZRangeWithScores(ctx, key, start, stop).Result()
+	} else {
+		scores, err = r.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]*model.Score, len(scores))
+	if len(scores) == 0 {
+		return result, nil
+	}
+
+	members := make([]string, len(scores))
+	for i, z := range scores {
+		members[i] = z.Member.(string)
+	}
+
+	metadata, err := r.client.HMGet(ctx, dataKey(key), members...).Result()
+	if err != nil {
+		return nil, err
+	}
 
 	for i, z := range scores {
 		result[i] = &model.Score{
-			ClientID: z.Member.(string),
-			Score:    z.Score,
+			ClientID: members[i],
+			Score:    decodeScore(board, z.Score),
+			Rank:     start + int64(i) + 1,
+		}
+
+		if m, ok := metadata[i].(string); ok {
+			result[i].Metadata = json.RawMessage(m)
+		}
+	}
+
+	return result, nil
+}
+
+// Scores get the scores of members in one round-trip and sort them by the order of board,
+// the equal scores are sorted by member as redis does, then the metadata of them is joined
+func (r *Repo) Scores(ctx context.Context, key string, members []string, board *model.Board) ([]*model.Score, error) {
+	result := []*model.Score{}
+	if len(members) == 0 {
+		return result, nil
+	}
+
+	cmds := make([]*goredis.FloatCmd, len(members))
+
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, m := range members {
+			cmds[i] = pipe.ZScore(ctx, key, m)
+		}
+		return nil
+	})
+	if err != nil && err != goredis.Nil {
+		return nil, err
+	}
+
+	stored := make(map[string]float64, len(members))
+	for i, cmd := range cmds {
+		score, err := cmd.Result()
+		if err == goredis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		stored[members[i]] = score
+		result = append(result, &model.Score{
+			ClientID: members[i],
+			Score:    decodeScore(board, score),
+		})
+	}
+
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	// compare the stored scores, so the time encoded by tie-break is kept
+	asc := board.Order == model.OrderAsc
+	sort.Slice(result, func(i, j int) bool {
+		a, b := stored[result[i].ClientID], stored[result[j].ClientID]
+		if a != b {
+			return (a < b) == asc
+		}
+		return (result[i].ClientID < result[j].ClientID) == asc
+	})
+
+	found := make([]string, len(result))
+	for i, s := range result {
+		found[i] = s.ClientID
+	}
+
+	metadata, err := r.client.HMGet(ctx, dataKey(key), found...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range result {
+		s.Rank = int64(i) + 1
+
+		if m, ok := metadata[i].(string); ok {
+			s.Metadata = json.RawMessage(m)
 		}
 	}
 
 	return result, nil
 }
 
-// DeleteAll
-func (r *Repo) DeleteAll(ctx context.Context) error {
-	iter := r.client.Scan(ctx, 0, "*", 0).Iterator()
-	for iter.Next(ctx) {
-		r.client.Del(ctx, iter.Val())
+// Score get score of member
+func (r *Repo) Score(ctx context.Context, key, member string, board *model.Board) (float64, error) {
+	score, err := r.client.ZScore(ctx, key, member).Result()
+	if err == goredis.Nil {
+		return 0, model.ErrPlayerNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeScore(board, score), nil
+}
+
+// Rank get 0-based rank of member by the order of board
+func (r *Repo) Rank(ctx context.Context, key, member string, board *model.Board) (int64, error) {
+	var cmd *goredis.IntCmd
+	if board.Order == model.OrderAsc {
+		cmd = r.client.ZRank(ctx, key, member)
+	} else {
+		cmd = r.client.ZRevRank(ctx, key, member)
 	}
 
-	return nil
+	rank, err := cmd.Result()
+	if err == goredis.Nil {
+		return 0, model.ErrPlayerNotFound
+	}
+
+	return rank, err
 }
 
-// SetExpire set key expire(TTL)
-func (r *Repo) SetExpire(ctx context.Context, key string, t time.Duration) error {
-	_, err := r.client.Expire(ctx, key, time.Minute*10).Result()
+// CountBetter count the members whose score is better than the decoded score
+func (r *Repo) CountBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, error) {
+	min, max := betterRange(board, score)
+
+	return r.client.ZCount(ctx, key, min, max).Result()
+}
+
+// CountDistinctBetter count the distinct scores which are better than the decoded score,
+// it loads at most MaxDenseDepth better members, and the count is estimated from them when there are more
+func (r *Repo) CountDistinctBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, bool, error) {
+	min, max := betterRange(board, score)
+
+	encoded := "0"
+	if board.TieBreakEnabled() {
+		encoded = "1"
+	}
+
+	res, err := distinctScript.Run(ctx, r.client, []string{key}, min, max, encoded, model.MaxDenseDepth).Int64Slice()
 	if err != nil {
-		return err
+		return 0, false, err
 	}
 
+	if len(res) != 2 {
+		return 0, false, ErrUnexpectedReply
+	}
+
+	return res[0], res[1] == 1, nil
+}
+
+// Count get the number of members
+func (r *Repo) Count(ctx context.Context, key string) (int64, error) {
+	return r.client.ZCard(ctx, key).Result()
+}
+
+// Remove remove member from the sorted sets of keys, and its metadata next to them
+func (r *Repo) Remove(ctx context.Context, keys []string, member string) error {
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.ZRem(ctx, key, member)
+			pipe.HDel(ctx, dataKey(key), member)
+		}
+		return nil
+	})
+
 	return err
 }
 
+// Reset archive the sorted set of board and its metadata as a new season, delete the entries, team standings and segments,
+// and prune the seasons beyond keep or ended before, it only touches the keys of board and is done atomically
+func (r *Repo) Reset(ctx context.Context, board *model.Board, segments []model.Segment, at time.Time, keep int64, before time.Time) (*model.ResetResult, error) {
+	key := board.Key()
+	keys := []string{
+		key, dataKey(key), board.SeasonsKey(), board.SeasonSeqKey(),
+		board.EntriesKey(), board.EntryDataKey(), board.EntrySeqKey(), board.TeamsKey(),
+	}
+	for _, s := range segments {
+		keys = append(keys, board.SegmentKey(s), dataKey(board.SegmentKey(s)))
+	}
+
+	var prune int64
+	if !before.IsZero() {
+		prune = before.Unix()
+	}
+
+	res, err := resetScript.Run(ctx, r.client, keys, board.SeasonKeyPrefix(), at.Unix(), keep, prune).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) != 3 {
+		return nil, ErrUnexpectedReply
+	}
+
+	return &model.ResetResult{
+		Board:   board.ID,
+		Season:  res[0],
+		Players: res[1],
+		Entries: res[2],
+	}, nil
+}
+
+// Union store the union of the weighted sources into key by the aggregate function, and expire it after ttl
+func (r *Repo) Union(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error) {
+	return r.store(ctx, key, false, sources, weights, aggregate, ttl)
+}
+
+// Intersect store the intersection of the weighted sources into key by the aggregate function, and expire it after ttl
+func (r *Repo) Intersect(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error) {
+	return r.store(ctx, key, true, sources, weights, aggregate, ttl)
+}
+
+// store the union or intersection with expiry atomically, the stale members of key are replaced
+func (r *Repo) store(ctx context.Context, key string, intersect bool, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error) {
+	store := &goredis.ZStore{
+		Keys:      sources,
+		Weights:   weights,
+		Aggregate: strings.ToUpper(string(aggregate)),
+	}
+
+	var count *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		if intersect {
+			count = pipe.ZInterStore(ctx, key, store)
+		} else {
+			count = pipe.ZUnionStore(ctx, key, store)
+		}
+
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
+
+// SetExpire set key and its metadata expire(TTL)
+func (r *Repo) SetExpire(ctx context.Context, key string, t time.Duration) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Expire(ctx, key, t)
+		pipe.Expire(ctx, dataKey(key), t)
+		return nil
+	})
+
+	return err
+}
+
+// dataKey - the hash key of the metadata of the sorted set, the field is member
+func dataKey(key string) string {
+	return key + ":data"
+}
+
 // Exists check key is exist
 func (r *Repo) Exists(ctx context.Context, key string) int64 {
 	count, err := r.client.Exists(ctx, key).Result()
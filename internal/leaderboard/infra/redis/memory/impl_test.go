@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"leaderboard/internal/leaderboard/domain/model"
 	"testing"
@@ -40,22 +41,23 @@ func (t *TestSuite) Test_Create() {
 		ctx   context.Context
 		key   string
 		score *model.Score
+		board *model.Board
 	}
 
+	ten, nine := float64(10), float64(9)
+
 	tests := []struct {
-		name      string
-		fn        func(args)
-		args      args
-		wantError bool
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.ScoreResult
+		wantError  bool
 	}{
 		{
-			name: "test zadd success",
+			name: "test create success",
 			fn: func(in args) {
-				z := &goredis.Z{
-					Member: in.score.ClientID,
-					Score:  in.score.Score,
-				}
-				t.mockClient.ExpectZAdd(in.key, z).SetVal(1)
+				t.mockClient.ExpectEvalSha(createScript.Hash(), []string{in.key, in.key + ":data"}, in.score.ClientID, in.score.Score, string(in.board.Update), "", string(in.score.Metadata), model.MaxTieBreakScore).
+					SetVal([]interface{}{nil, "5.1"})
 			},
 			args: args{
 				ctx: context.Background(),
@@ -63,24 +65,119 @@ func (t *TestSuite) Test_Create() {
 				score: &model.Score{
 					ClientID: "test_adam",
 					Score:    5.1,
+					Metadata: json.RawMessage(`{"level":3,"replayId":"r-1"}`),
 				},
+				board: &model.Board{Update: model.UpdateLatest},
+			},
+			wantResult: &model.ScoreResult{
+				ClientID: "test_adam",
+				Score:    5.1,
+				Changed:  true,
 			},
 			wantError: false,
 		},
 		{
-			name: "test member is empty",
+			name: "test create lower score with max policy",
 			fn: func(in args) {
-				z := &goredis.Z{
-					Score: in.score.Score,
-				}
-				t.mockClient.ExpectZAdd(in.key, z)
+				t.mockClient.ExpectEvalSha(createScript.Hash(), []string{in.key, in.key + ":data"}, in.score.ClientID, in.score.Score, string(in.board.Update), "", string(in.score.Metadata), model.MaxTieBreakScore).
+					SetVal([]interface{}{"10", "10"})
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "leaderboard",
+				score: &model.Score{
+					ClientID: "test_adam",
+					Score:    5.1,
+				},
+				board: &model.Board{Update: model.UpdateMax},
+			},
+			wantResult: &model.ScoreResult{
+				ClientID: "test_adam",
+				Score:    10,
+				Changed:  false,
+				Previous: &ten,
+			},
+			wantError: false,
+		},
+		{
+			name: "test create error",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(createScript.Hash(), []string{in.key, in.key + ":data"}, in.score.ClientID, in.score.Score, string(in.board.Update), "", string(in.score.Metadata), model.MaxTieBreakScore).
+					SetErr(errors.New(""))
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "leaderboard",
+				score: &model.Score{
+					ClientID: "test_adam",
+					Score:    5.1,
+				},
+				board: &model.Board{Update: model.UpdateIncrement},
+			},
+			wantError: true,
+		},
+		{
+			name: "test create with tie-break",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(createScript.Hash(), []string{in.key, in.key + ":data"}, in.score.ClientID, in.score.Score, string(in.board.Update), "0.25", "", model.MaxTieBreakScore).
+					SetVal([]interface{}{"9.5", "10.25"})
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "leaderboard",
+				score: &model.Score{
+					ClientID:  "test_adam",
+					Score:     10,
+					CreatedAt: 1000 + tieScale/4,
+				},
+				board: &model.Board{
+					Order:     model.OrderDesc,
+					Update:    model.UpdateMax,
+					TieBreak:  model.TieBreakLast,
+					CreatedAt: 1000,
+				},
+			},
+			wantResult: &model.ScoreResult{
+				ClientID: "test_adam",
+				Score:    10,
+				Changed:  true,
+				Previous: &nine,
 			},
+			wantError: false,
+		},
+		{
+			name: "test adjust with tie-break out of range",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(createScript.Hash(), []string{in.key, in.key + ":data"}, in.score.ClientID, in.score.Score, string(in.board.Update), "0", "", model.MaxTieBreakScore).
+					SetVal([]interface{}{nil, nil, int64(1)})
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "leaderboard",
+				score: &model.Score{
+					ClientID:  "test_adam",
+					Score:     10,
+					CreatedAt: 1000,
+				},
+				board: &model.Board{
+					Order:     model.OrderDesc,
+					Update:    model.UpdateIncrement,
+					TieBreak:  model.TieBreakLast,
+					CreatedAt: 1000,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test member is empty",
+			fn:   func(in args) {},
 			args: args{
 				ctx: context.Background(),
 				key: "leaderboard",
 				score: &model.Score{
 					Score: 5.1,
 				},
+				board: &model.Board{Update: model.UpdateLatest},
 			},
 			wantError: true,
 		},
@@ -90,8 +187,67 @@ func (t *TestSuite) Test_Create() {
 		t.Run(test.name, func() {
 			test.fn(test.args)
 
-			got := t.Repo.Create(test.args.ctx, test.args.key, test.args.score)
-			t.Equal(test.wantError, got != nil)
+			got, err := t.Repo.Create(test.args.ctx, test.args.key, test.args.score, test.args.board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_CreateBatch
+func (t *TestSuite) Test_CreateBatch() {
+	board := &model.Board{Update: model.UpdateMax}
+	keys := []string{"leaderboard", "leaderboard:data"}
+	scores := []*model.Score{
+		{ClientID: "adam", Score: 30},
+		{ClientID: "bob", Score: 20},
+	}
+	previous := float64(25)
+
+	tests := []struct {
+		name       string
+		fn         func()
+		scores     []*model.Score
+		wantResult []*model.ScoreResult
+		wantError  bool
+	}{
+		{
+			name: "test create batch success",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(createScript.Hash(), keys, "adam", float64(30), "max", "", "", model.MaxTieBreakScore).SetVal([]interface{}{nil, "30"})
+				t.mockClient.ExpectEvalSha(createScript.Hash(), keys, "bob", float64(20), "max", "", "", model.MaxTieBreakScore).SetVal([]interface{}{"25", "25"})
+			},
+			scores: scores,
+			wantResult: []*model.ScoreResult{
+				{ClientID: "adam", Score: 30, Changed: true},
+				{ClientID: "bob", Score: 25, Changed: false, Previous: &previous},
+			},
+		},
+		{
+			name: "test create batch error case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(createScript.Hash(), keys, "adam", float64(30), "max", "", "", model.MaxTieBreakScore).SetErr(errors.New(""))
+			},
+			scores:    scores[:1],
+			wantError: true,
+		},
+		{
+			name:      "test create batch with empty member case",
+			fn:        func() {},
+			scores:    []*model.Score{{Score: 10}},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.CreateBatch(context.Background(), "leaderboard", test.scores, board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
 			t.mockClient.ClearExpect()
 		})
 	}
@@ -100,10 +256,11 @@ func (t *TestSuite) Test_Create() {
 // Test_List
 func (t *TestSuite) Test_List() {
 	type args struct {
-		ctx    context.Context
-		key    string
-		offset int64
-		limit  int64
+		ctx   context.Context
+		key   string
+		start int64
+		stop  int64
+		board *model.Board
 	}
 
 	tests := []struct {
@@ -127,22 +284,94 @@ func (t *TestSuite) Test_List() {
 					},
 				}
 
-				t.mockClient.ExpectZRevRangeWithScores(in.key, in.offset, in.limit).SetVal(res)
+				t.mockClient.ExpectZRevRangeWithScores(in.key, in.start, in.stop).SetVal(res)
+				t.mockClient.ExpectHMGet(in.key+":data", "a", "b").SetVal([]interface{}{`{"level":3}`, nil})
 			},
 			args: args{
-				ctx:    context.Background(),
-				key:    "leaderboard",
-				offset: 0,
-				limit:  9,
+				ctx:   context.Background(),
+				key:   "leaderboard",
+				start: 0,
+				stop:  9,
+				board: &model.Board{Order: model.OrderDesc},
 			},
 			wantResult: []*model.Score{
 				{
 					ClientID: "a",
 					Score:    40,
+					Rank:     1,
+					Metadata: json.RawMessage(`{"level":3}`),
+				},
+				{
+					ClientID: "b",
+					Score:    30,
+					Rank:     2,
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "test get list from start case",
+			fn: func(in args) {
+				res := []goredis.Z{
+					{
+						Member: "c",
+						Score:  20,
+					},
+				}
+
+				t.mockClient.ExpectZRevRangeWithScores(in.key, in.start, in.stop).SetVal(res)
+				t.mockClient.ExpectHMGet(in.key+":data", "c").SetVal([]interface{}{nil})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "leaderboard",
+				start: 2,
+				stop:  4,
+				board: &model.Board{Order: model.OrderDesc},
+			},
+			wantResult: []*model.Score{
+				{
+					ClientID: "c",
+					Score:    20,
+					Rank:     3,
 				},
+			},
+			wantError: false,
+		},
+		{
+			name: "test get ascending list case",
+			fn: func(in args) {
+				res := []goredis.Z{
+					{
+						Member: "b",
+						Score:  30,
+					},
+					{
+						Member: "a",
+						Score:  40,
+					},
+				}
+
+				t.mockClient.ExpectZRangeWithScores(in.key, in.start, in.stop).SetVal(res)
+				t.mockClient.ExpectHMGet(in.key+":data", "b", "a").SetVal([]interface{}{nil, nil})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "leaderboard",
+				start: 0,
+				stop:  9,
+				board: &model.Board{Order: model.OrderAsc},
+			},
+			wantResult: []*model.Score{
 				{
 					ClientID: "b",
 					Score:    30,
+					Rank:     1,
+				},
+				{
+					ClientID: "a",
+					Score:    40,
+					Rank:     2,
 				},
 			},
 			wantError: false,
@@ -153,7 +382,7 @@ func (t *TestSuite) Test_List() {
 		t.Run(test.name, func() {
 			test.fn(test.args)
 
-			got, err := t.Repo.List(test.args.ctx, test.args.key, test.args.offset, test.args.limit)
+			got, err := t.Repo.List(test.args.ctx, test.args.key, test.args.start, test.args.stop, test.args.board)
 			t.Equal(test.wantError, err != nil)
 			t.Equal(got, test.wantResult)
 
@@ -162,27 +391,174 @@ func (t *TestSuite) Test_List() {
 	}
 }
 
-// Test_DeleteAll
-func (t *TestSuite) Test_DeleteAll() {
+// Test_Reset
+func (t *TestSuite) Test_Reset() {
 	type args struct {
-		ctx context.Context
+		ctx    context.Context
+		board  *model.Board
+		at     time.Time
+		keep   int64
+		before time.Time
+	}
+
+	board := &model.Board{ID: "default"}
+	keys := []string{
+		"board:default", "board:default:data", "board:default:seasons", "board:default:seasons:seq",
+		"board:default:entries", "board:default:entries:data", "board:default:entries:seq", "board:default:teams",
+		"board:default:segment:country:TW", "board:default:segment:country:TW:data",
 	}
 
 	tests := []struct {
-		name      string
-		fn        func(args)
-		args      args
-		wantError bool
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.ResetResult
+		wantError  bool
 	}{
 		{
-			name: "test deleteAll case",
+			name: "test reset board case",
 			fn: func(in args) {
-				t.mockClient.ExpectScan(0, "*", 0)
+				t.mockClient.ExpectEvalSha(resetScript.Hash(), keys, "board:default:season:", int64(1664553600), int64(10), int64(1661961600)).
+					SetVal([]interface{}{int64(3), int64(42), int64(7)})
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx:    context.Background(),
+				board:  board,
+				at:     time.Unix(1664553600, 0),
+				keep:   10,
+				before: time.Unix(1661961600, 0),
 			},
-			wantError: false,
+			wantResult: &model.ResetResult{
+				Board:   "default",
+				Season:  3,
+				Players: 42,
+				Entries: 7,
+			},
+		},
+		{
+			name: "test reset empty board without retention case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(resetScript.Hash(), keys, "board:default:season:", int64(1664553600), int64(0), int64(0)).
+					SetVal([]interface{}{int64(0), int64(0), int64(0)})
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: board,
+				at:    time.Unix(1664553600, 0),
+			},
+			wantResult: &model.ResetResult{
+				Board: "default",
+			},
+		},
+		{
+			name: "test reset board error case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(resetScript.Hash(), keys, "board:default:season:", int64(1664553600), int64(0), int64(0)).
+					SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:   context.Background(),
+				board: board,
+				at:    time.Unix(1664553600, 0),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.Reset(test.args.ctx, test.args.board, []model.Segment{{Name: "country", Value: "TW"}}, test.args.at, test.args.keep, test.args.before)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Aggregate
+func (t *TestSuite) Test_Aggregate() {
+	type args struct {
+		ctx       context.Context
+		intersect bool
+		key       string
+		sources   []string
+		weights   []float64
+		aggregate model.AggregateFunc
+		ttl       time.Duration
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult int64
+		wantError  bool
+	}{
+		{
+			name: "test union case",
+			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
+				t.mockClient.ExpectZUnionStore(in.key, &goredis.ZStore{
+					Keys:      in.sources,
+					Weights:   in.weights,
+					Aggregate: "SUM",
+				}).SetVal(12)
+				t.mockClient.ExpectExpire(in.key, in.ttl).SetVal(true)
+				t.mockClient.ExpectTxPipelineExec()
+			},
+			args: args{
+				ctx:       context.Background(),
+				key:       "board:week",
+				sources:   []string{"board:default:daily:2026-10-18", "board:default:daily:2026-10-17"},
+				weights:   []float64{1, 1},
+				aggregate: model.AggregateSum,
+				ttl:       time.Minute,
+			},
+			wantResult: 12,
+		},
+		{
+			name: "test intersect case",
+			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
+				t.mockClient.ExpectZInterStore(in.key, &goredis.ZStore{
+					Keys:      in.sources,
+					Weights:   in.weights,
+					Aggregate: "MAX",
+				}).SetVal(3)
+				t.mockClient.ExpectTxPipelineExec()
+			},
+			args: args{
+				ctx:       context.Background(),
+				intersect: true,
+				key:       "board:modes",
+				sources:   []string{"board:racing", "board:arena"},
+				weights:   []float64{1, 0.5},
+				aggregate: model.AggregateMax,
+			},
+			wantResult: 3,
+		},
+		{
+			name: "test union error case",
+			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
+				t.mockClient.ExpectZUnionStore(in.key, &goredis.ZStore{
+					Keys:      in.sources,
+					Weights:   in.weights,
+					Aggregate: "MIN",
+				}).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:       context.Background(),
+				key:       "board:modes",
+				sources:   []string{"board:racing"},
+				weights:   []float64{1},
+				aggregate: model.AggregateMin,
+			},
+			wantError: true,
 		},
 	}
 
@@ -190,8 +566,18 @@ func (t *TestSuite) Test_DeleteAll() {
 		t.Run(test.name, func() {
 			test.fn(test.args)
 
-			err := t.Repo.DeleteAll(test.args.ctx)
+			var (
+				got int64
+				err error
+			)
+			if test.args.intersect {
+				got, err = t.Repo.Intersect(test.args.ctx, test.args.key, test.args.sources, test.args.weights, test.args.aggregate, test.args.ttl)
+			} else {
+				got, err = t.Repo.Union(test.args.ctx, test.args.key, test.args.sources, test.args.weights, test.args.aggregate, test.args.ttl)
+			}
 			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
 
 			t.mockClient.ClearExpect()
 		})
@@ -215,7 +601,10 @@ func (t *TestSuite) Test_SetExpire() {
 		{
 			name: "test SetExpire success case",
 			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
 				t.mockClient.ExpectExpire(in.key, in.time).SetVal(true)
+				t.mockClient.ExpectExpire(in.key+":data", in.time).SetVal(true)
+				t.mockClient.ExpectTxPipelineExec()
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -227,7 +616,10 @@ func (t *TestSuite) Test_SetExpire() {
 		{
 			name: "test SetExpire error case",
 			fn: func(in args) {
+				t.mockClient.ExpectTxPipeline()
 				t.mockClient.ExpectExpire(in.key, in.time).SetErr(errors.New(""))
+				t.mockClient.ExpectExpire(in.key+":data", in.time).SetVal(true)
+				t.mockClient.ExpectTxPipelineExec()
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -287,3 +679,520 @@ func (t *TestSuite) Test_Exists() {
 		})
 	}
 }
+
+// Test_Score
+func (t *TestSuite) Test_Score() {
+	type args struct {
+		ctx    context.Context
+		key    string
+		member string
+		board  *model.Board
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult float64
+		wantError  error
+	}{
+		{
+			name: "test Score success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, in.member).SetVal(10.5)
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{},
+			},
+			wantResult: 10.5,
+		},
+		{
+			name: "test Score member not found case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, in.member).RedisNil()
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{},
+			},
+			wantError: model.ErrPlayerNotFound,
+		},
+		{
+			name: "test Score with tie-break case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, in.member).SetVal(10.25)
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{TieBreak: model.TieBreakLast},
+			},
+			wantResult: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.Score(test.args.ctx, test.args.key, test.args.member, test.args.board)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Scores
+func (t *TestSuite) Test_Scores() {
+	type args struct {
+		ctx     context.Context
+		key     string
+		members []string
+		board   *model.Board
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult []*model.Score
+		wantError  bool
+	}{
+		{
+			name: "test Scores sorted desc case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, "peter").SetVal(20)
+				t.mockClient.ExpectZScore(in.key, "adam").SetVal(30)
+				t.mockClient.ExpectZScore(in.key, "john").SetVal(20)
+				t.mockClient.ExpectHMGet("board:default:data", "adam", "peter", "john").SetVal([]interface{}{`{"level":3}`, nil, nil})
+			},
+			args: args{
+				ctx:     context.Background(),
+				key:     "board:default",
+				members: []string{"peter", "adam", "john"},
+				board:   &model.Board{Order: model.OrderDesc},
+			},
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 30, Rank: 1, Metadata: json.RawMessage(`{"level":3}`)},
+				{ClientID: "peter", Score: 20, Rank: 2},
+				{ClientID: "john", Score: 20, Rank: 3},
+			},
+		},
+		{
+			name: "test Scores sorted asc with tie-break case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, "peter").SetVal(20.75)
+				t.mockClient.ExpectZScore(in.key, "adam").SetVal(20.25)
+				t.mockClient.ExpectHMGet("board:race:data", "adam", "peter").SetVal([]interface{}{nil, nil})
+			},
+			args: args{
+				ctx:     context.Background(),
+				key:     "board:race",
+				members: []string{"peter", "adam"},
+				board:   &model.Board{Order: model.OrderAsc, TieBreak: model.TieBreakFirst},
+			},
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 20, Rank: 1},
+				{ClientID: "peter", Score: 20, Rank: 2},
+			},
+		},
+		{
+			name: "test Scores no score case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, "adam").RedisNil()
+			},
+			args: args{
+				ctx:     context.Background(),
+				key:     "board:default",
+				members: []string{"adam"},
+				board:   &model.Board{},
+			},
+			wantResult: []*model.Score{},
+		},
+		{
+			name: "test Scores no member case",
+			fn:   func(in args) {},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				board: &model.Board{},
+			},
+			wantResult: []*model.Score{},
+		},
+		{
+			name: "test Scores error case",
+			fn: func(in args) {
+				t.mockClient.ExpectZScore(in.key, "adam").SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:     context.Background(),
+				key:     "board:default",
+				members: []string{"adam"},
+				board:   &model.Board{},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.Scores(test.args.ctx, test.args.key, test.args.members, test.args.board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Rank
+func (t *TestSuite) Test_Rank() {
+	type args struct {
+		ctx    context.Context
+		key    string
+		member string
+		board  *model.Board
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult int64
+		wantError  error
+	}{
+		{
+			name: "test Rank success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRevRank(in.key, in.member).SetVal(3)
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{Order: model.OrderDesc},
+			},
+			wantResult: 3,
+		},
+		{
+			name: "test Rank member not found case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRevRank(in.key, in.member).RedisNil()
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{Order: model.OrderDesc},
+			},
+			wantError: model.ErrPlayerNotFound,
+		},
+		{
+			name: "test ascending Rank success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZRank(in.key, in.member).SetVal(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    "board:default",
+				member: "adam",
+				board:  &model.Board{Order: model.OrderAsc},
+			},
+			wantResult: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.Rank(test.args.ctx, test.args.key, test.args.member, test.args.board)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Count
+func (t *TestSuite) Test_Count() {
+	type args struct {
+		ctx context.Context
+		key string
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult int64
+		wantError  bool
+	}{
+		{
+			name: "test Count success case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCard(in.key).SetVal(20)
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "board:default",
+			},
+			wantResult: 20,
+		},
+		{
+			name: "test Count error case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCard(in.key).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "board:default",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.Count(test.args.ctx, test.args.key)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_Remove
+func (t *TestSuite) Test_Remove() {
+	keys := []string{"board:default", "board:default:segment:country:TW"}
+
+	tests := []struct {
+		name      string
+		fn        func()
+		wantError bool
+	}{
+		{
+			name: "test Remove success case",
+			fn: func() {
+				t.mockClient.ExpectZRem("board:default", "adam").SetVal(1)
+				t.mockClient.ExpectHDel("board:default:data", "adam").SetVal(1)
+				t.mockClient.ExpectZRem("board:default:segment:country:TW", "adam").SetVal(0)
+				t.mockClient.ExpectHDel("board:default:segment:country:TW:data", "adam").SetVal(0)
+			},
+		},
+		{
+			name: "test Remove error case",
+			fn: func() {
+				t.mockClient.ExpectZRem("board:default", "adam").SetErr(errors.New(""))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			err := t.Repo.Remove(context.Background(), keys, "adam")
+			t.Equal(test.wantError, err != nil)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_CountBetter
+func (t *TestSuite) Test_CountBetter() {
+	type args struct {
+		ctx   context.Context
+		key   string
+		score float64
+		board *model.Board
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult int64
+		wantError  bool
+	}{
+		{
+			name: "test CountBetter desc case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCount(in.key, "(10", "+inf").SetVal(3)
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc},
+			},
+			wantResult: 3,
+		},
+		{
+			name: "test CountBetter asc case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCount(in.key, "-inf", "(10").SetVal(2)
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderAsc},
+			},
+			wantResult: 2,
+		},
+		{
+			name: "test CountBetter desc with tie-break case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCount(in.key, "11", "+inf").SetVal(1)
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc, TieBreak: model.TieBreakFirst},
+			},
+			wantResult: 1,
+		},
+		{
+			name: "test CountBetter error case",
+			fn: func(in args) {
+				t.mockClient.ExpectZCount(in.key, "(10", "+inf").SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.CountBetter(test.args.ctx, test.args.key, test.args.score, test.args.board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_CountDistinctBetter
+func (t *TestSuite) Test_CountDistinctBetter() {
+	type args struct {
+		ctx   context.Context
+		key   string
+		score float64
+		board *model.Board
+	}
+
+	tests := []struct {
+		name            string
+		fn              func(args)
+		args            args
+		wantResult      int64
+		wantApproximate bool
+		wantError       bool
+	}{
+		{
+			name: "test CountDistinctBetter success case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(distinctScript.Hash(), []string{in.key}, "(10", "+inf", "0", model.MaxDenseDepth).SetVal([]interface{}{int64(2), int64(0)})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc},
+			},
+			wantResult: 2,
+		},
+		{
+			name: "test CountDistinctBetter with tie-break case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(distinctScript.Hash(), []string{in.key}, "11", "+inf", "1", model.MaxDenseDepth).SetVal([]interface{}{int64(4), int64(0)})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc, TieBreak: model.TieBreakLast},
+			},
+			wantResult: 4,
+		},
+		{
+			name: "test CountDistinctBetter deep rank estimated case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(distinctScript.Hash(), []string{in.key}, "(10", "+inf", "0", model.MaxDenseDepth).SetVal([]interface{}{int64(15000), int64(1)})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc},
+			},
+			wantResult:      15000,
+			wantApproximate: true,
+		},
+		{
+			name: "test CountDistinctBetter unexpected reply case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(distinctScript.Hash(), []string{in.key}, "(10", "+inf", "0", model.MaxDenseDepth).SetVal([]interface{}{int64(2)})
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{Order: model.OrderDesc},
+			},
+			wantError: true,
+		},
+		{
+			name: "test CountDistinctBetter error case",
+			fn: func(in args) {
+				t.mockClient.ExpectEvalSha(distinctScript.Hash(), []string{in.key}, "(10", "+inf", "0", model.MaxDenseDepth).SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:   context.Background(),
+				key:   "board:default",
+				score: 10,
+				board: &model.Board{},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, approximate, err := t.Repo.CountDistinctBetter(test.args.ctx, test.args.key, test.args.score, test.args.board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.Equal(test.wantApproximate, approximate)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
@@ -3,12 +3,16 @@ package memory
 import (
 	"leaderboard/config"
 	"leaderboard/internal/leaderboard/domain/repository"
+	"time"
 
 	goredis "github.com/go-redis/redis/v8"
 )
 
 type Repo struct {
 	client *goredis.Client
+
+	// auditIndexTTL how long the index of the events of player is kept after its latest event
+	auditIndexTTL time.Duration
 }
 
 // NewRepository -
@@ -17,3 +21,74 @@ func NewRepository(client *goredis.Client, c config.Config) repository.LeaderBoa
 		client: client,
 	}
 }
+
+// NewBoardRepository -
+func NewBoardRepository(client *goredis.Client, c config.Config) repository.BoardRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewEntryRepository -
+func NewEntryRepository(client *goredis.Client, c config.Config) repository.EntryRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewPlayerRepository -
+func NewPlayerRepository(client *goredis.Client, c config.Config) repository.PlayerRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewSeasonRepository -
+func NewSeasonRepository(client *goredis.Client, c config.Config) repository.SeasonRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewTeamRepository -
+func NewTeamRepository(client *goredis.Client, c config.Config) repository.TeamRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewIdempotencyRepository -
+func NewIdempotencyRepository(client *goredis.Client, c config.Config) repository.IdempotencyRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewRuleRepository -
+func NewRuleRepository(client *goredis.Client, c config.Config) repository.RuleRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewNonceRepository -
+func NewNonceRepository(client *goredis.Client, c config.Config) repository.NonceRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewBanRepository -
+func NewBanRepository(client *goredis.Client, c config.Config) repository.BanRepository {
+	return &Repo{
+		client: client,
+	}
+}
+
+// NewAuditRepository -
+func NewAuditRepository(client *goredis.Client, c config.Config) repository.AuditRepository {
+	return &Repo{
+		client:        client,
+		auditIndexTTL: c.Audit.IndexTTL,
+	}
+}
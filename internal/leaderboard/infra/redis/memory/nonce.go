@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// nonceKeyPrefix - the prefix of nonce keys, followed by game id and nonce
+const nonceKeyPrefix = "nonce:"
+
+// SaveNonce record the nonce only when it is not recorded
+func (r *Repo) SaveNonce(ctx context.Context, game, nonce string, ttl time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, nonceKey(game, nonce), 1, ttl).Result()
+}
+
+// nonceKey - the string key of the nonce of game
+func nonceKey(game, nonce string) string {
+	return nonceKeyPrefix + game + ":" + nonce
+}
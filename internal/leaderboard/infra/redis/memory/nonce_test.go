@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// Test_SaveNonce
+func (t *TestSuite) Test_SaveNonce() {
+	t.mockClient.ExpectSetNX("nonce:racing:n-0001", 1, time.Minute*10).SetVal(true)
+
+	ok, err := t.Repo.SaveNonce(context.Background(), "racing", "n-0001", time.Minute*10)
+	t.NoError(err)
+	t.True(ok)
+
+	// the replayed nonce is not recorded again
+	t.mockClient.ExpectSetNX("nonce:racing:n-0001", 1, time.Minute*10).SetVal(false)
+
+	ok, err = t.Repo.SaveNonce(context.Background(), "racing", "n-0001", time.Minute*10)
+	t.NoError(err)
+	t.False(ok)
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
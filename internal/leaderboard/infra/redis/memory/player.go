@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+const (
+	// profileDisplayName, profileAvatarURL, profileCountry - the fields of profile hash
+	profileDisplayName = "displayName"
+	profileAvatarURL   = "avatarUrl"
+	profileCountry     = "country"
+)
+
+// SaveProfile save profile of player, it replaces the previous profile
+func (r *Repo) SaveProfile(ctx context.Context, profile *model.Profile) error {
+	if profile.ClientID == "" {
+		return ErrEmptyMember
+	}
+
+	key := profileKey(profile.ClientID)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key,
+			profileDisplayName, profile.DisplayName,
+			profileAvatarURL, profile.AvatarURL,
+			profileCountry, profile.Country,
+		)
+		return nil
+	})
+
+	return err
+}
+
+// GetProfile get profile of player
+func (r *Repo) GetProfile(ctx context.Context, clientID string) (*model.Profile, error) {
+	fields, err := r.client.HGetAll(ctx, profileKey(clientID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, model.ErrProfileNotFound
+	}
+
+	return newProfile(clientID, fields), nil
+}
+
+// GetProfiles get profiles of players in one round-trip
+func (r *Repo) GetProfiles(ctx context.Context, clientIDs []string) (map[string]*model.Profile, error) {
+	result := make(map[string]*model.Profile, len(clientIDs))
+	if len(clientIDs) == 0 {
+		return result, nil
+	}
+
+	cmds := make([]*goredis.StringStringMapCmd, len(clientIDs))
+
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, id := range clientIDs {
+			cmds[i] = pipe.HGetAll(ctx, profileKey(id))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		if fields := cmd.Val(); len(fields) > 0 {
+			result[clientIDs[i]] = newProfile(clientIDs[i], fields)
+		}
+	}
+
+	return result, nil
+}
+
+// SaveFriends replace the friends of player
+func (r *Repo) SaveFriends(ctx context.Context, clientID string, friends []string) error {
+	if clientID == "" {
+		return ErrEmptyMember
+	}
+
+	key := friendsKey(clientID)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if len(friends) > 0 {
+			members := make([]interface{}, len(friends))
+			for i, f := range friends {
+				members[i] = f
+			}
+			pipe.SAdd(ctx, key, members...)
+		}
+		return nil
+	})
+
+	return err
+}
+
+// GetFriends get the friends of player
+func (r *Repo) GetFriends(ctx context.Context, clientID string) ([]string, error) {
+	return r.client.SMembers(ctx, friendsKey(clientID)).Result()
+}
+
+// profileKey - the hash key of player profile
+func profileKey(clientID string) string {
+	return "player:" + clientID + ":profile"
+}
+
+func newProfile(clientID string, fields map[string]string) *model.Profile {
+	return &model.Profile{
+		ClientID:    clientID,
+		DisplayName: fields[profileDisplayName],
+		AvatarURL:   fields[profileAvatarURL],
+		Country:     fields[profileCountry],
+	}
+}
+
+// friendsKey - the set key of player friends
+func friendsKey(clientID string) string {
+	return "player:" + clientID + ":friends"
+}
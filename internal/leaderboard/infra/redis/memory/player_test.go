@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// Test_SaveProfile
+func (t *TestSuite) Test_SaveProfile() {
+	profile := &model.Profile{
+		ClientID:    "adam",
+		DisplayName: "Adam",
+		Country:     "TW",
+	}
+
+	t.mockClient.ExpectTxPipeline()
+	t.mockClient.ExpectDel("player:adam:profile").SetVal(1)
+	t.mockClient.ExpectHSet("player:adam:profile", "displayName", "Adam", "avatarUrl", "", "country", "TW").SetVal(3)
+	t.mockClient.ExpectTxPipelineExec()
+
+	t.NoError(t.Repo.SaveProfile(context.Background(), profile))
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_SaveFriends
+func (t *TestSuite) Test_SaveFriends() {
+	t.mockClient.ExpectTxPipeline()
+	t.mockClient.ExpectDel("player:adam:friends").SetVal(1)
+	t.mockClient.ExpectSAdd("player:adam:friends", "peter", "mary").SetVal(2)
+	t.mockClient.ExpectTxPipelineExec()
+
+	t.NoError(t.Repo.SaveFriends(context.Background(), "adam", []string{"peter", "mary"}))
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	// no friend only clears the previous friends
+	t.mockClient.ExpectTxPipeline()
+	t.mockClient.ExpectDel("player:adam:friends").SetVal(1)
+	t.mockClient.ExpectTxPipelineExec()
+
+	t.NoError(t.Repo.SaveFriends(context.Background(), "adam", nil))
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.Equal(ErrEmptyMember, t.Repo.SaveFriends(context.Background(), "", nil))
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_GetFriends
+func (t *TestSuite) Test_GetFriends() {
+	t.mockClient.ExpectSMembers("player:adam:friends").SetVal([]string{"peter", "mary"})
+
+	got, err := t.Repo.GetFriends(context.Background(), "adam")
+	t.NoError(err)
+	t.Equal([]string{"peter", "mary"}, got)
+
+	t.mockClient.ExpectSMembers("player:adam:friends").SetErr(errors.New(""))
+
+	_, err = t.Repo.GetFriends(context.Background(), "adam")
+	t.Error(err)
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_GetProfile
+func (t *TestSuite) Test_GetProfile() {
+	type args struct {
+		ctx      context.Context
+		clientID string
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Profile
+		wantError  error
+	}{
+		{
+			name: "test get profile case",
+			fn: func(in args) {
+				t.mockClient.ExpectHGetAll("player:adam:profile").SetVal(map[string]string{
+					"displayName": "Adam",
+					"avatarUrl":   "https://example.com/adam.png",
+					"country":     "TW",
+				})
+			},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "adam",
+			},
+			wantResult: &model.Profile{
+				ClientID:    "adam",
+				DisplayName: "Adam",
+				AvatarURL:   "https://example.com/adam.png",
+				Country:     "TW",
+			},
+		},
+		{
+			name: "test profile not found case",
+			fn: func(in args) {
+				t.mockClient.ExpectHGetAll("player:peter:profile").SetVal(map[string]string{})
+			},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "peter",
+			},
+			wantError: model.ErrProfileNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.GetProfile(test.args.ctx, test.args.clientID)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_GetProfiles
+func (t *TestSuite) Test_GetProfiles() {
+	type args struct {
+		ctx       context.Context
+		clientIDs []string
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult map[string]*model.Profile
+		wantError  bool
+	}{
+		{
+			name: "test get profiles case",
+			fn: func(in args) {
+				t.mockClient.ExpectHGetAll("player:adam:profile").SetVal(map[string]string{
+					"displayName": "Adam",
+				})
+				t.mockClient.ExpectHGetAll("player:peter:profile").SetVal(map[string]string{})
+			},
+			args: args{
+				ctx:       context.Background(),
+				clientIDs: []string{"adam", "peter"},
+			},
+			wantResult: map[string]*model.Profile{
+				"adam": {ClientID: "adam", DisplayName: "Adam"},
+			},
+		},
+		{
+			name: "test get profiles without client case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantResult: map[string]*model.Profile{},
+		},
+		{
+			name: "test get profiles error case",
+			fn: func(in args) {
+				t.mockClient.ExpectHGetAll("player:adam:profile").SetErr(errors.New(""))
+			},
+			args: args{
+				ctx:       context.Background(),
+				clientIDs: []string{"adam"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.Repo.GetProfiles(test.args.ctx, test.args.clientIDs)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/pkg/encoder/json"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// CountSubmission count the submission by the counter of the minute, the counter expires after the minute ends
+func (r *Repo) CountSubmission(ctx context.Context, board *model.Board, clientID string, at time.Time) (int64, error) {
+	if clientID == "" {
+		return 0, ErrEmptyMember
+	}
+
+	minute := at.Truncate(time.Minute)
+	key := board.RateKey(clientID, minute.Unix()/60)
+
+	var count *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		count = pipe.Incr(ctx, key)
+		pipe.ExpireAt(ctx, key, minute.Add(time.Minute*2))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
+
+// Quarantine push the rejected submission to the head of the quarantine list, and trim the oldest beyond max
+func (r *Repo) Quarantine(ctx context.Context, board *model.Board, submission *model.Quarantined, max int64) error {
+	value, err := json.NewEncoder().Encode(submission)
+	if err != nil {
+		return err
+	}
+
+	key := board.QuarantineKey()
+
+	_, err = r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.LPush(ctx, key, value)
+		pipe.LTrim(ctx, key, 0, max-1)
+		return nil
+	})
+
+	return err
+}
+
+// ListQuarantine list the quarantined submissions between 0-based start and stop index(inclusive), the latest first
+func (r *Repo) ListQuarantine(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Quarantined, error) {
+	values, err := r.client.LRange(ctx, board.QuarantineKey(), start, stop).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	coder := json.NewEncoder()
+	result := make([]*model.Quarantined, len(values))
+	for i, v := range values {
+		result[i] = &model.Quarantined{}
+		if err := coder.Decode([]byte(v), result[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// CountQuarantine get the number of quarantined submissions
+func (r *Repo) CountQuarantine(ctx context.Context, board *model.Board) (int64, error) {
+	return r.client.LLen(ctx, board.QuarantineKey()).Result()
+}
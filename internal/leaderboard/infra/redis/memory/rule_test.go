@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+)
+
+// Test_CountSubmission
+func (t *TestSuite) Test_CountSubmission() {
+	board := &model.Board{ID: "ranked"}
+	at := time.Date(2026, 10, 18, 13, 30, 20, 0, time.UTC)
+
+	t.mockClient.ExpectTxPipeline()
+	t.mockClient.ExpectIncr("board:ranked:rate:adam:29872170").SetVal(3)
+	t.mockClient.ExpectExpireAt("board:ranked:rate:adam:29872170", time.Date(2026, 10, 18, 13, 32, 0, 0, time.UTC)).SetVal(true)
+	t.mockClient.ExpectTxPipelineExec()
+
+	got, err := t.Repo.CountSubmission(context.Background(), board, "adam", at)
+	t.NoError(err)
+	t.Equal(int64(3), got)
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_Quarantine
+func (t *TestSuite) Test_Quarantine() {
+	board := &model.Board{ID: "ranked"}
+	submission := &model.Quarantined{ClientID: "adam", Score: 5000, Reason: "score is out of range", CreatedAt: 1760000000}
+	value := `{"clientId":"adam","score":5000,"reason":"score is out of range","createdAt":1760000000}`
+
+	t.mockClient.ExpectTxPipeline()
+	t.mockClient.ExpectLPush("board:ranked:quarantine", []byte(value)).SetVal(1)
+	t.mockClient.ExpectLTrim("board:ranked:quarantine", 0, 999).SetVal("OK")
+	t.mockClient.ExpectTxPipelineExec()
+
+	t.NoError(t.Repo.Quarantine(context.Background(), board, submission, 1000))
+
+	t.mockClient.ExpectLRange("board:ranked:quarantine", 0, 9).SetVal([]string{value})
+
+	got, err := t.Repo.ListQuarantine(context.Background(), board, 0, 9)
+	t.NoError(err)
+	t.Equal([]*model.Quarantined{submission}, got)
+
+	t.mockClient.ExpectLLen("board:ranked:quarantine").SetVal(1)
+
+	total, err := t.Repo.CountQuarantine(context.Background(), board)
+	t.NoError(err)
+	t.Equal(int64(1), total)
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.mockClient.ClearExpect()
+}
@@ -0,0 +1,264 @@
+package memory
+
+import goredis "github.com/go-redis/redis/v8"
+
+// createScript record score by update policy atomically, the metadata follows the stored score
+// KEYS[1] - sorted set key, KEYS[2] - metadata hash key
+// ARGV[1] - member, ARGV[2] - score, ARGV[3] - update policy, ARGV[4] - time fraction, empty when tie-break is disabled
+// ARGV[5] - metadata, empty when there is no metadata, ARGV[6] - the max absolute score of tie-break
+// return {previous stored score or nil, stored score}, or {nil, nil, 1} when the accumulated score is out of the range of tie-break
+var createScript = goredis.NewScript(`
+local old = redis.call('ZSCORE', KEYS[1], ARGV[1])
+local policy, score, fraction = ARGV[3], ARGV[2], ARGV[4]
+
+if fraction ~= '' then
+	-- the encoded score can not be accumulated, so accumulate the decoded score
+	if policy == 'increment' then
+		if old then
+			score = math.floor(tonumber(old)) + tonumber(score)
+		end
+		-- the fraction can not be kept beyond the range, the score would be rounded
+		if math.abs(tonumber(score)) >= tonumber(ARGV[6]) then
+			return {false, false, 1}
+		end
+		policy = 'latest'
+	end
+	score = tonumber(score) + tonumber(fraction)
+end
+
+if policy == 'increment' then
+	redis.call('ZINCRBY', KEYS[1], score, ARGV[1])
+elseif policy == 'max' then
+	redis.call('ZADD', KEYS[1], 'GT', score, ARGV[1])
+elseif policy == 'min' then
+	redis.call('ZADD', KEYS[1], 'LT', score, ARGV[1])
+else
+	redis.call('ZADD', KEYS[1], score, ARGV[1])
+end
+
+local new = redis.call('ZSCORE', KEYS[1], ARGV[1])
+
+-- the metadata describes the submission of stored score, so replace it only when the stored score is replaced
+if policy == 'latest' or not old or new ~= old then
+	if ARGV[5] ~= '' then
+		redis.call('HSET', KEYS[2], ARGV[1], ARGV[5])
+	else
+		redis.call('HDEL', KEYS[2], ARGV[1])
+	end
+end
+
+return {old, new}
+`)
+
+// distinctScript count the distinct scores within range, at most limit members within range are loaded,
+// so the count is estimated from the first limit members when there are more
+// KEYS[1] - sorted set key
+// ARGV[1] - min, ARGV[2] - max, ARGV[3] - 1 when the scores are encoded with time fraction, ARGV[4] - limit
+// return {the number of distinct scores, 1 when it is estimated otherwise 0}
+var distinctScript = goredis.NewScript(`
+local total, limit = redis.call('ZCOUNT', KEYS[1], ARGV[1], ARGV[2]), tonumber(ARGV[4])
+
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], ARGV[1], ARGV[2], 'WITHSCORES', 'LIMIT', 0, limit)
+local count, last = 0, nil
+
+for i = 2, #items, 2 do
+	local score = tonumber(items[i])
+	if ARGV[3] == '1' then
+		score = math.floor(score)
+	end
+
+	if score ~= last then
+		count = count + 1
+		last = score
+	end
+end
+
+if total <= limit then
+	return {count, 0}
+end
+
+-- the members beyond limit are assumed to have the same ratio of distinct scores
+return {math.floor(count * total / limit + 0.5), 1}
+`)
+
+// entryScript record a new entry atomically
+// KEYS[1] - entries sorted set key, KEYS[2] - entry metadata hash key, KEYS[3] - entry id sequence key
+// ARGV[1] - score, ARGV[2] - entry metadata, ARGV[3] - TTL in seconds extended by the entry, 0 means no TTL
+// return the entry id
+var entryScript = goredis.NewScript(`
+local id = tostring(redis.call('INCR', KEYS[3]))
+
+redis.call('HSET', KEYS[2], id, ARGV[2])
+redis.call('ZADD', KEYS[1], ARGV[1], id)
+
+local ttl = tonumber(ARGV[3])
+if ttl > 0 then
+	for i = 1, #KEYS do
+		redis.call('EXPIRE', KEYS[i], ttl)
+	end
+end
+
+return id
+`)
+
+// resetScript reset one board atomically, only the keys of the board are touched:
+// the sorted set and its metadata are moved to a new season, the entries, team standings and segments are deleted, and the expired seasons are pruned.
+// The season keys are named by the sequence, so they can not be declared before running
+// KEYS[1] - sorted set key, KEYS[2] - metadata hash key, KEYS[3] - season index key, KEYS[4] - season id sequence key
+// KEYS[5] - entries sorted set key, KEYS[6] - entry metadata hash key, KEYS[7] - entry id sequence key
+// KEYS[8] - team standings sorted set key, KEYS[9...] - segment sorted set keys and their metadata hash keys
+// ARGV[1] - season key prefix, ARGV[2] - the time season ended
+// ARGV[3] - the number of the latest seasons kept, ARGV[4] - the seasons ended before it are pruned, 0 means no limit
+// return {season id or 0 when the sorted set is empty, the number of players, the number of entries}
+var resetScript = goredis.NewScript(`
+local players = redis.call('ZCARD', KEYS[1])
+local entries = redis.call('ZCARD', KEYS[5])
+local id = 0
+
+if players > 0 then
+	id = redis.call('INCR', KEYS[4])
+	local season = ARGV[1] .. id
+
+	-- the board reset by TTL has expiry, the archived season is kept by the retention instead
+	redis.call('RENAME', KEYS[1], season)
+	redis.call('PERSIST', season)
+
+	if redis.call('EXISTS', KEYS[2]) == 1 then
+		redis.call('RENAME', KEYS[2], season .. ':data')
+		redis.call('PERSIST', season .. ':data')
+	end
+
+	redis.call('ZADD', KEYS[3], ARGV[2], id)
+else
+	redis.call('DEL', KEYS[2])
+end
+
+redis.call('DEL', KEYS[5], KEYS[6], KEYS[7], KEYS[8])
+
+for i = 9, #KEYS do
+	redis.call('DEL', KEYS[i])
+end
+
+local expired = {}
+local keep, before = tonumber(ARGV[3]), tonumber(ARGV[4])
+
+if keep > 0 then
+	for _, s in ipairs(redis.call('ZREVRANGE', KEYS[3], keep, -1)) do
+		expired[s] = true
+	end
+end
+
+if before > 0 then
+	for _, s in ipairs(redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', '(' .. before)) do
+		expired[s] = true
+	end
+end
+
+for s in pairs(expired) do
+	redis.call('DEL', ARGV[1] .. s, ARGV[1] .. s .. ':data')
+	redis.call('ZREM', KEYS[3], s)
+end
+
+return {id, players, entries}
+`)
+
+// joinScript move player to team atomically, the previous team key is named by the team id stored,
+// so it can not be declared before running
+// KEYS[1] - the team key of player, KEYS[2] - members set key of team
+// ARGV[1] - team id, ARGV[2] - client id, ARGV[3] - the max members of team, ARGV[4] - team key prefix
+// return {1 when joined or 0 when team is full, the previous team id or empty}
+var joinScript = goredis.NewScript(`
+local previous = redis.call('GET', KEYS[1]) or ''
+
+if previous == ARGV[1] then
+	return {1, previous}
+end
+
+if redis.call('SCARD', KEYS[2]) >= tonumber(ARGV[3]) then
+	return {0, previous}
+end
+
+if previous ~= '' then
+	redis.call('SREM', ARGV[4] .. previous .. ':members', ARGV[2])
+end
+
+redis.call('SET', KEYS[1], ARGV[1])
+redis.call('SADD', KEYS[2], ARGV[2])
+
+return {1, previous}
+`)
+
+// leaveScript remove player from team when player is its member
+// KEYS[1] - the team key of player, KEYS[2] - members set key of team
+// ARGV[1] - team id, ARGV[2] - client id
+// return 1 when removed, 0 when player is not the member
+var leaveScript = goredis.NewScript(`
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+
+redis.call('DEL', KEYS[1])
+redis.call('SREM', KEYS[2], ARGV[2])
+
+return 1
+`)
+
+// teamScoreScript recompute the score of team as the sum of the best scores of its members
+// KEYS[1] - sorted set key of board, KEYS[2] - team standings sorted set key, KEYS[3] - members set key of team
+// ARGV[1] - team id, ARGV[2] - the number of the best members summed, 0 means all
+// ARGV[3] - order of board, ARGV[4] - 1 when the scores are encoded with time by tie-break
+// return the number of members with score
+var teamScoreScript = goredis.NewScript(`
+local scores = {}
+
+for _, member in ipairs(redis.call('SMEMBERS', KEYS[3])) do
+	local score = redis.call('ZSCORE', KEYS[1], member)
+	if score then
+		score = tonumber(score)
+		if ARGV[4] == '1' then
+			score = math.floor(score)
+		end
+		table.insert(scores, score)
+	end
+end
+
+if #scores == 0 then
+	redis.call('ZREM', KEYS[2], ARGV[1])
+	return 0
+end
+
+if ARGV[3] == 'asc' then
+	table.sort(scores)
+else
+	table.sort(scores, function(a, b) return a > b end)
+end
+
+local top = tonumber(ARGV[2])
+if top == 0 or top > #scores then
+	top = #scores
+end
+
+local sum = 0
+for i = 1, top do
+	sum = sum + scores[i]
+end
+
+redis.call('ZADD', KEYS[2], string.format('%.17g', sum), ARGV[1])
+
+return #scores
+`)
+
+// reserveScript reserve the idempotency key for the request in progress atomically
+// KEYS[1] - idempotency key
+// ARGV[1] - the request in progress, ARGV[2] - ttl in milliseconds
+// return the recorded request, or nil when the key is reserved
+var reserveScript = goredis.NewScript(`
+local recorded = redis.call('GET', KEYS[1])
+if recorded then
+	return recorded
+end
+
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+
+return false
+`)
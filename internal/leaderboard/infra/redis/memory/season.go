@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"strconv"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// ListSeasons list the seasons of board with the number of players, the latest first
+func (r *Repo) ListSeasons(ctx context.Context, board *model.Board) ([]*model.Season, error) {
+	items, err := r.client.ZRevRangeWithScores(ctx, board.SeasonsKey(), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Season, len(items))
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	cmds := make([]*goredis.IntCmd, len(items))
+	_, err = r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, z := range items {
+			id, _ := strconv.ParseInt(z.Member.(string), 10, 64)
+
+			result[i] = &model.Season{
+				ID:      id,
+				Board:   board.ID,
+				EndedAt: int64(z.Score),
+			}
+			cmds[i] = pipe.ZCard(ctx, board.SeasonKey(id))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		result[i].Total = cmd.Val()
+	}
+
+	return result, nil
+}
+
+// GetSeason get one season of board
+func (r *Repo) GetSeason(ctx context.Context, board *model.Board, id int64) (*model.Season, error) {
+	endedAt, err := r.client.ZScore(ctx, board.SeasonsKey(), strconv.FormatInt(id, 10)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return nil, model.ErrSeasonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := r.client.ZCard(ctx, board.SeasonKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Season{
+		ID:      id,
+		Board:   board.ID,
+		EndedAt: int64(endedAt),
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// Test_ListSeasons
+func (t *TestSuite) Test_ListSeasons() {
+	board := &model.Board{ID: "default"}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		wantResult []*model.Season
+		wantError  bool
+	}{
+		{
+			name: "test list seasons success case",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeWithScores("board:default:seasons", 0, -1).SetVal([]goredis.Z{
+					{Member: "2", Score: 1664557200},
+					{Member: "1", Score: 1664553600},
+				})
+				t.mockClient.ExpectZCard("board:default:season:2").SetVal(5)
+				t.mockClient.ExpectZCard("board:default:season:1").SetVal(8)
+			},
+			wantResult: []*model.Season{
+				{ID: 2, Board: "default", EndedAt: 1664557200, Total: 5},
+				{ID: 1, Board: "default", EndedAt: 1664553600, Total: 8},
+			},
+		},
+		{
+			name: "test list no season case",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeWithScores("board:default:seasons", 0, -1).SetVal([]goredis.Z{})
+			},
+			wantResult: []*model.Season{},
+		},
+		{
+			name: "test list seasons error case",
+			fn: func() {
+				t.mockClient.ExpectZRevRangeWithScores("board:default:seasons", 0, -1).SetErr(errors.New(""))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.ListSeasons(context.Background(), board)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_GetSeason
+func (t *TestSuite) Test_GetSeason() {
+	board := &model.Board{ID: "default"}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		id         int64
+		wantResult *model.Season
+		wantError  error
+	}{
+		{
+			name: "test get season success case",
+			fn: func() {
+				t.mockClient.ExpectZScore("board:default:seasons", "2").SetVal(1664557200)
+				t.mockClient.ExpectZCard("board:default:season:2").SetVal(5)
+			},
+			id:         2,
+			wantResult: &model.Season{ID: 2, Board: "default", EndedAt: 1664557200, Total: 5},
+		},
+		{
+			name: "test season not found case",
+			fn: func() {
+				t.mockClient.ExpectZScore("board:default:seasons", "9").RedisNil()
+			},
+			id:        9,
+			wantError: model.ErrSeasonNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.GetSeason(context.Background(), board, test.id)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
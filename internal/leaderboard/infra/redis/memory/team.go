@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+const (
+	// teamName - the field of team hash
+	teamName = "name"
+
+	// teamKeyPrefix - the prefix of team keys, followed by team id
+	teamKeyPrefix = "team:"
+)
+
+// SaveTeam save the name of team
+func (r *Repo) SaveTeam(ctx context.Context, team *model.Team) error {
+	if team.ID == "" {
+		return ErrEmptyMember
+	}
+
+	return r.client.HSet(ctx, teamKey(team.ID), teamName, team.Name).Err()
+}
+
+// GetTeam get team with its members in one round-trip
+func (r *Repo) GetTeam(ctx context.Context, id string) (*model.Team, error) {
+	var (
+		name    *goredis.StringCmd
+		members *goredis.StringSliceCmd
+	)
+
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		name = pipe.HGet(ctx, teamKey(id), teamName)
+		members = pipe.SMembers(ctx, teamMembersKey(id))
+		return nil
+	})
+	if err == goredis.Nil {
+		return nil, model.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Team{
+		ID:      id,
+		Name:    name.Val(),
+		Members: members.Val(),
+	}, nil
+}
+
+// GetPlayerTeam get the team id of player
+func (r *Repo) GetPlayerTeam(ctx context.Context, clientID string) (string, error) {
+	team, err := r.client.Get(ctx, playerTeamKey(clientID)).Result()
+	if err == goredis.Nil {
+		return "", nil
+	}
+
+	return team, err
+}
+
+// JoinTeam move player to team, and return the previous team of player
+func (r *Repo) JoinTeam(ctx context.Context, team, clientID string, max int64) (string, error) {
+	if clientID == "" {
+		return "", ErrEmptyMember
+	}
+
+	keys := []string{playerTeamKey(clientID), teamMembersKey(team)}
+
+	res, err := joinScript.Run(ctx, r.client, keys, team, clientID, max, teamKeyPrefix).Slice()
+	if err != nil {
+		return "", err
+	}
+
+	if len(res) != 2 {
+		return "", ErrUnexpectedReply
+	}
+
+	if joined, _ := res[0].(int64); joined == 0 {
+		return "", model.ErrTeamFull
+	}
+
+	previous, _ := res[1].(string)
+
+	return previous, nil
+}
+
+// LeaveTeam remove player from team
+func (r *Repo) LeaveTeam(ctx context.Context, team, clientID string) error {
+	keys := []string{playerTeamKey(clientID), teamMembersKey(team)}
+
+	left, err := leaveScript.Run(ctx, r.client, keys, team, clientID).Int64()
+	if err != nil {
+		return err
+	}
+
+	if left == 0 {
+		return model.ErrNotTeamMember
+	}
+
+	return nil
+}
+
+// UpdateTeamScore recompute the score of team on board atomically,
+// the scores of members are decoded, so the team standings have no tie-break
+func (r *Repo) UpdateTeamScore(ctx context.Context, board *model.Board, team string) error {
+	keys := []string{board.Key(), board.TeamsKey(), teamMembersKey(team)}
+
+	encoded := "0"
+	if board.TieBreakEnabled() {
+		encoded = "1"
+	}
+
+	return teamScoreScript.Run(ctx, r.client, keys, team, board.Teams.Top, string(board.Order), encoded).Err()
+}
+
+// teamKey - the hash key of team
+func teamKey(id string) string {
+	return teamKeyPrefix + id
+}
+
+// teamMembersKey - the set key of team members
+func teamMembersKey(id string) string {
+	return teamKey(id) + ":members"
+}
+
+// playerTeamKey - the key of the team id of player
+func playerTeamKey(clientID string) string {
+	return "player:" + clientID + ":team"
+}
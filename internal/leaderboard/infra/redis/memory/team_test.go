@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// Test_SaveTeam
+func (t *TestSuite) Test_SaveTeam() {
+	t.mockClient.ExpectHSet("team:wolves", "name", "Wolves").SetVal(1)
+
+	t.NoError(t.Repo.SaveTeam(context.Background(), &model.Team{ID: "wolves", Name: "Wolves"}))
+	t.NoError(t.mockClient.ExpectationsWereMet())
+
+	t.Equal(ErrEmptyMember, t.Repo.SaveTeam(context.Background(), &model.Team{}))
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_GetTeam
+func (t *TestSuite) Test_GetTeam() {
+	tests := []struct {
+		name       string
+		fn         func()
+		id         string
+		wantResult *model.Team
+		wantError  error
+	}{
+		{
+			name: "test get team case",
+			fn: func() {
+				t.mockClient.ExpectHGet("team:wolves", "name").SetVal("Wolves")
+				t.mockClient.ExpectSMembers("team:wolves:members").SetVal([]string{"adam", "peter"})
+			},
+			id:         "wolves",
+			wantResult: &model.Team{ID: "wolves", Name: "Wolves", Members: []string{"adam", "peter"}},
+		},
+		{
+			name: "test team not found case",
+			fn: func() {
+				t.mockClient.ExpectHGet("team:bears", "name").RedisNil()
+			},
+			id:        "bears",
+			wantError: model.ErrTeamNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.GetTeam(context.Background(), test.id)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_GetPlayerTeam
+func (t *TestSuite) Test_GetPlayerTeam() {
+	t.mockClient.ExpectGet("player:adam:team").SetVal("wolves")
+
+	team, err := t.Repo.GetPlayerTeam(context.Background(), "adam")
+	t.NoError(err)
+	t.Equal("wolves", team)
+
+	// the player without team
+	t.mockClient.ExpectGet("player:peter:team").RedisNil()
+
+	team, err = t.Repo.GetPlayerTeam(context.Background(), "peter")
+	t.NoError(err)
+	t.Equal("", team)
+
+	t.mockClient.ClearExpect()
+}
+
+// Test_JoinTeam
+func (t *TestSuite) Test_JoinTeam() {
+	keys := []string{"player:adam:team", "team:wolves:members"}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		wantResult string
+		wantError  error
+	}{
+		{
+			name: "test move to team case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(joinScript.Hash(), keys, "wolves", "adam", int64(100), "team:").SetVal([]interface{}{int64(1), "bears"})
+			},
+			wantResult: "bears",
+		},
+		{
+			name: "test join first team case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(joinScript.Hash(), keys, "wolves", "adam", int64(100), "team:").SetVal([]interface{}{int64(1), ""})
+			},
+		},
+		{
+			name: "test team is full case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(joinScript.Hash(), keys, "wolves", "adam", int64(100), "team:").SetVal([]interface{}{int64(0), ""})
+			},
+			wantError: model.ErrTeamFull,
+		},
+		{
+			name: "test join team error case",
+			fn: func() {
+				t.mockClient.ExpectEvalSha(joinScript.Hash(), keys, "wolves", "adam", int64(100), "team:").SetErr(errors.New("error"))
+			},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.Repo.JoinTeam(context.Background(), "wolves", "adam", 100)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+			t.NoError(t.mockClient.ExpectationsWereMet())
+
+			t.mockClient.ClearExpect()
+		})
+	}
+}
+
+// Test_LeaveTeam
+func (t *TestSuite) Test_LeaveTeam() {
+	keys := []string{"player:adam:team", "team:wolves:members"}
+
+	t.mockClient.ExpectEvalSha(leaveScript.Hash(), keys, "wolves", "adam").SetVal(int64(1))
+	t.NoError(t.Repo.LeaveTeam(context.Background(), "wolves", "adam"))
+
+	t.mockClient.ExpectEvalSha(leaveScript.Hash(), keys, "wolves", "adam").SetVal(int64(0))
+	t.Equal(model.ErrNotTeamMember, t.Repo.LeaveTeam(context.Background(), "wolves", "adam"))
+
+	t.NoError(t.mockClient.ExpectationsWereMet())
+	t.mockClient.ClearExpect()
+}
+
+// Test_UpdateTeamScore
+func (t *TestSuite) Test_UpdateTeamScore() {
+	keys := []string{"board:racing", "board:racing:teams", "team:wolves:members"}
+
+	board := &model.Board{ID: "racing", Order: model.OrderAsc, Teams: &model.Teams{Top: 3}}
+	t.mockClient.ExpectEvalSha(teamScoreScript.Hash(), keys, "wolves", int64(3), "asc", "0").SetVal(int64(4))
+	t.NoError(t.Repo.UpdateTeamScore(context.Background(), board, "wolves"))
+
+	// the scores encoded by tie-break are decoded
+	board = &model.Board{ID: "racing", Order: model.OrderDesc, TieBreak: model.TieBreakFirst, Teams: &model.Teams{}}
+	t.mockClient.ExpectEvalSha(teamScoreScript.Hash(), keys, "wolves", int64(0), "desc", "1").SetErr(errors.New(""))
+	t.Error(t.Repo.UpdateTeamScore(context.Background(), board, "wolves"))
+
+	t.NoError(t.mockClient.ExpectationsWereMet())
+	t.mockClient.ClearExpect()
+}
@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"leaderboard/internal/leaderboard/domain/model"
+	"math"
+	"strconv"
+)
+
+const (
+	// tieBits - the bits of fraction encoding the time, 2^28 seconds is about 8.5 years
+	tieBits = 28
+
+	tieScale = 1 << tieBits
+)
+
+// encodeFraction - encode the time reaching the score to a fraction within [0, 1),
+// the player who should rank higher gets the larger fraction on desc board and smaller on asc board
+func encodeFraction(board *model.Board, at int64) float64 {
+	dt := at - board.CreatedAt
+	if dt < 0 {
+		dt = 0
+	}
+	if dt >= tieScale {
+		dt = tieScale - 1
+	}
+
+	// the earlier time gets the larger fraction when first wins on desc board or last wins on asc board
+	if (board.TieBreak == model.TieBreakFirst) == (board.Order != model.OrderAsc) {
+		dt = tieScale - 1 - dt
+	}
+
+	return float64(dt) / tieScale
+}
+
+// decodeScore - remove the time fraction from the stored score
+func decodeScore(board *model.Board, score float64) float64 {
+	if !board.TieBreakEnabled() {
+		return score
+	}
+
+	return math.Floor(score)
+}
+
+// betterRange - the range of stored score which is better than the decoded score
+func betterRange(board *model.Board, score float64) (min, max string) {
+	if board.Order == model.OrderAsc {
+		return "-inf", "(" + formatFloat(score)
+	}
+
+	if board.TieBreakEnabled() {
+		return formatFloat(score + 1), "+inf"
+	}
+
+	return "(" + formatFloat(score), "+inf"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
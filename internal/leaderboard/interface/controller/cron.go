@@ -2,24 +2,173 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/board"
 	"leaderboard/internal/leaderboard/usecase/score"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 )
 
-// NewCron
-func NewCron(ctx context.Context, usecase score.ScoreUsecase, logger *zap.Logger) *cron.Cron {
-	c := cron.New()
+// ErrInvalidInterval -
+var ErrInvalidInterval = errors.New("schedule sync interval must be positive")
 
-	c.AddFunc("*/10 * * * *", func() {
-		logger.Sugar().Info("start cron job")
-		retry(3, time.Duration(time.Second), usecase.ResetLeaderBoard)
-	})
+// Scheduler reset the boards and refresh the aggregated boards by their own cron schedules. The schedules
+// are reloaded from the boards periodically, so the boards created or deleted by admin API are followed
+type Scheduler struct {
+	ctx          context.Context
+	cron         *cron.Cron
+	usecase      score.ScoreUsecase
+	boardUsecase board.BoardUsecase
+	logger       *zap.Logger
+	interval     time.Duration
 
-	return c
+	mu   sync.Mutex
+	jobs map[string]job
+}
+
+// job the scheduled task of one board
+type job struct {
+	spec string
+	id   cron.EntryID
+}
+
+// task the task to schedule
+type task struct {
+	spec string
+	run  func()
+}
+
+// NewScheduler
+func NewScheduler(ctx context.Context, conf config.Config, usecase score.ScoreUsecase, boardUsecase board.BoardUsecase, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		ctx:          ctx,
+		cron:         cron.New(),
+		usecase:      usecase,
+		boardUsecase: boardUsecase,
+		logger:       logger,
+		interval:     conf.Schedule.Sync,
+		jobs:         map[string]job{},
+	}
+}
+
+// Start load the schedules of boards and start cron, it fails when the schedules can not be reloaded periodically by the interval
+func (s *Scheduler) Start() error {
+	// cron runs the job every second when the interval is not positive
+	if s.interval <= 0 {
+		s.logger.Sugar().Error("schedule sync failed: ", ErrInvalidInterval)
+		return ErrInvalidInterval
+	}
+
+	if _, err := s.cron.AddFunc("@every "+s.interval.String(), func() {
+		s.Sync(s.ctx)
+	}); err != nil {
+		s.logger.Sugar().Error("schedule sync failed: ", err)
+		return err
+	}
+
+	s.Sync(s.ctx)
+
+	s.cron.Start()
+
+	return nil
+}
+
+// Stop stop cron and wait for the running resets
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+// Sync schedule the boards reset by cron and the aggregated boards refreshed by cron,
+// and remove the schedules of the boards deleted or changed
+func (s *Scheduler) Sync(ctx context.Context) error {
+	boards, err := s.boardUsecase.List(ctx)
+	if err != nil {
+		s.logger.Sugar().Error("list boards failed: ", err)
+		return err
+	}
+
+	tasks := make(map[string]task, len(boards))
+	for _, b := range boards {
+		// the aggregated board has nothing to reset, it is only refreshed
+		if b.Reset == model.ResetCron && !b.Aggregated() {
+			tasks["reset:"+b.ID] = task{spec: b.CronSpec(), run: s.reset(b.ID)}
+		}
+
+		if b.Aggregated() && b.Aggregate.Refresh != "" {
+			tasks["refresh:"+b.ID] = task{spec: b.Aggregate.Refresh, run: s.refresh(b.ID)}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, j := range s.jobs {
+		if t, ok := tasks[name]; !ok || t.spec != j.spec {
+			s.cron.Remove(j.id)
+			delete(s.jobs, name)
+		}
+	}
+
+	for name, t := range tasks {
+		if _, ok := s.jobs[name]; ok {
+			continue
+		}
+
+		entry, err := s.cron.AddFunc(t.spec, t.run)
+		if err != nil {
+			s.logger.Sugar().Error("schedule ", name, " failed: ", err)
+			continue
+		}
+
+		s.jobs[name] = job{
+			spec: t.spec,
+			id:   entry,
+		}
+	}
+
+	return nil
+}
+
+// reset the cron job resetting one board
+func (s *Scheduler) reset(id string) func() {
+	return func() {
+		s.logger.Sugar().Info("reset board ", id)
+
+		var result *model.ResetResult
+		err := retry(3, time.Duration(time.Second), func(ctx context.Context) (err error) {
+			result, err = s.usecase.ResetLeaderBoard(ctx, id)
+			return err
+		})
+		if err != nil {
+			s.logger.Sugar().Error("reset board ", id, " failed: ", err)
+			return
+		}
+
+		s.logger.Sugar().Info("board ", id, " reset, season: ", result.Season, ", players: ", result.Players, ", entries: ", result.Entries)
+	}
+}
+
+// refresh the cron job materialising one aggregated board
+func (s *Scheduler) refresh(id string) func() {
+	return func() {
+		var players int64
+		err := retry(3, time.Duration(time.Second), func(ctx context.Context) (err error) {
+			players, err = s.usecase.RefreshAggregate(ctx, id)
+			return err
+		})
+		if err != nil {
+			s.logger.Sugar().Error("refresh board ", id, " failed: ", err)
+			return
+		}
+
+		s.logger.Sugar().Info("board ", id, " refreshed, players: ", players)
+	}
 }
 
 func retry(attempts int, sleep time.Duration, f func(ctx context.Context) error) error {
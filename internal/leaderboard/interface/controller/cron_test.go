@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	socre "leaderboard/test/mock/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// schedulerSuite
+type schedulerSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockBoardUsecase *socre.MockBoardUsecase
+	scheduler        *Scheduler
+}
+
+// SetupTest
+func (s *schedulerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockBoardUsecase = socre.NewMockBoardUsecase(s.ctrl)
+
+	s.scheduler = &Scheduler{
+		ctx:          context.Background(),
+		cron:         cron.New(),
+		usecase:      socre.NewMockScoreUsecase(s.ctrl),
+		boardUsecase: s.mockBoardUsecase,
+		logger:       zap.NewNop(),
+		jobs:         map[string]job{},
+	}
+}
+
+// TestScheduler
+func TestScheduler(t *testing.T) {
+	suite.Run(t, new(schedulerSuite))
+}
+
+// Test_Sync
+func (s *schedulerSuite) Test_Sync() {
+	boards := []*model.Board{
+		{ID: model.DefaultBoard, Reset: model.ResetTTL, TTL: 600},
+		{ID: "daily", Reset: model.ResetCron, Cron: "0 0 * * *", Timezone: "Asia/Taipei"},
+		{ID: "weekly", Reset: model.ResetCron, Cron: "0 0 * * 1", Timezone: "UTC"},
+		{ID: "modes", Reset: model.ResetNever, Aggregate: &model.Aggregate{Refresh: "*/5 * * * *"}},
+		{ID: "combined", Reset: model.ResetCron, Cron: "0 0 * * *", Aggregate: &model.Aggregate{}},
+	}
+
+	s.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(boards, nil).Times(1)
+	s.NoError(s.scheduler.Sync(context.Background()))
+	s.Len(s.scheduler.jobs, 3)
+	s.Len(s.scheduler.cron.Entries(), 3)
+	s.Equal("*/5 * * * *", s.scheduler.jobs["refresh:modes"].spec)
+	weekly := s.scheduler.jobs["reset:weekly"]
+
+	// the daily board changes its schedule, the weekly board and the aggregated board are deleted
+	changed := []*model.Board{
+		{ID: model.DefaultBoard, Reset: model.ResetTTL, TTL: 600},
+		{ID: "daily", Reset: model.ResetCron, Cron: "0 12 * * *", Timezone: "Asia/Taipei"},
+	}
+
+	s.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(changed, nil).Times(1)
+	s.NoError(s.scheduler.Sync(context.Background()))
+	s.Len(s.scheduler.jobs, 1)
+	s.Len(s.scheduler.cron.Entries(), 1)
+	s.Equal("CRON_TZ=Asia/Taipei 0 12 * * *", s.scheduler.jobs["reset:daily"].spec)
+	s.False(s.scheduler.cron.Entry(weekly.id).Valid())
+
+	// keep the schedules when boards can not be listed
+	s.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(nil, errors.New("")).Times(1)
+	s.Error(s.scheduler.Sync(context.Background()))
+	s.Len(s.scheduler.jobs, 1)
+}
+
+// Test_Start
+func (s *schedulerSuite) Test_Start() {
+	s.scheduler.interval = time.Minute
+
+	boards := []*model.Board{
+		{ID: "daily", Reset: model.ResetCron, Cron: "0 0 * * *", Timezone: "Asia/Taipei"},
+	}
+
+	s.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(boards, nil).Times(1)
+	s.NoError(s.scheduler.Start())
+	s.Len(s.scheduler.jobs, 1)
+	// the sync job and the reset of daily board
+	s.Len(s.scheduler.cron.Entries(), 2)
+	s.scheduler.Stop()
+
+	// the interval is not positive, nothing is started
+	s.scheduler.cron = cron.New()
+	s.scheduler.jobs = map[string]job{}
+	s.scheduler.interval = 0
+	s.Equal(ErrInvalidInterval, s.scheduler.Start())
+	s.Empty(s.scheduler.jobs)
+	s.Empty(s.scheduler.cron.Entries())
+
+	// the sync job can not be scheduled, nothing is started
+	s.scheduler.interval = time.Minute
+	s.scheduler.cron = cron.New(cron.WithParser(cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)))
+	s.scheduler.jobs = map[string]job{}
+	s.Error(s.scheduler.Start())
+	s.Empty(s.scheduler.jobs)
+	s.Empty(s.scheduler.cron.Entries())
+}
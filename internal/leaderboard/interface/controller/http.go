@@ -3,17 +3,37 @@ package controller
 import (
 	"leaderboard/config"
 	leaderboard_v1 "leaderboard/internal/leaderboard/interface/controller/v1"
+	"leaderboard/internal/leaderboard/usecase/auth"
+	"leaderboard/internal/leaderboard/usecase/board"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/player"
 	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/signature"
+	"leaderboard/internal/leaderboard/usecase/team"
 	"net/http"
 
 	"github.com/kataras/iris/v12"
 )
 
 // NewHTTPServer -
-func NewHTTPServer(conf config.Config, scoreUsecase score.ScoreUsecase) http.Handler {
+func NewHTTPServer(conf config.Config, scoreUsecase score.ScoreUsecase, boardUsecase board.BoardUsecase, playerUsecase player.PlayerUsecase, teamUsecase team.TeamUsecase, idempotencyUsecase idempotency.IdempotencyUsecase, signatureUsecase signature.SignatureUsecase, authUsecase auth.AuthUsecase) http.Handler {
 	h := leaderboard_v1.Server{
-		App:          iris.New(),
-		ScoreUsecase: scoreUsecase,
+		App:                iris.New(),
+		ScoreUsecase:       scoreUsecase,
+		BoardUsecase:       boardUsecase,
+		PlayerUsecase:      playerUsecase,
+		TeamUsecase:        teamUsecase,
+		IdempotencyUsecase: idempotencyUsecase,
+	}
+
+	// the submissions are signed by the game servers only when it is enabled
+	if conf.Signature.Enabled {
+		h.SignatureUsecase = signatureUsecase
+	}
+
+	// the requests carry the token of player or the API key of game server only when it is enabled
+	if conf.Auth.Enabled {
+		h.AuthUsecase = authUsecase
 	}
 
 	h.SetRouter()
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/auth"
+	"leaderboard/pkg/response"
+	"strings"
+
+	"github.com/kataras/iris/v12"
+)
+
+const (
+	// CodeUnauthorized - the error code of the request without valid credentials
+	CodeUnauthorized = "unauthorized"
+
+	// CodeForbidden - the error code of the credentials without the scope of route
+	CodeForbidden = "forbidden"
+
+	// principalKey - the key of the authenticated principal in the context values
+	principalKey = "principal"
+)
+
+var (
+	// ErrForbidden -
+	ErrForbidden = errors.New("insufficient scope")
+)
+
+// Authorize - authenticate the bearer token or API key, and check the scope required by the route,
+// the subject of player replaces the ClientId header, the game servers keep the ClientId they send
+func (s *Server) Authorize(c *C) {
+	p, err := s.AuthUsecase.Authenticate(c.Request().Context(), &auth.Credential{
+		Token:  bearer(c.GetHeader("Authorization")),
+		APIKey: c.GetHeader("X-Api-Key"),
+	})
+	if err != nil {
+		c.E(response.WithCode(CodeUnauthorized, err))
+		return
+	}
+
+	if !p.Has(requiredScope(c)) {
+		c.E(response.WithCode(CodeForbidden, ErrForbidden))
+		return
+	}
+
+	if !p.Server {
+		c.Request().Header.Set("ClientId", p.Subject)
+	}
+	c.Values().Set(principalKey, p)
+
+	c.Next()
+}
+
+// Principal get the authenticated principal, it is nil when the authentication is disabled
+func (c *C) Principal() *model.Principal {
+	p, _ := c.Values().Get(principalKey).(*model.Principal)
+	return p
+}
+
+// Actor get the subject of the authenticated principal, it is anonymous when the authentication is disabled
+func (c *C) Actor() string {
+	if p := c.Principal(); p != nil {
+		return p.Subject
+	}
+
+	return "anonymous"
+}
+
+// requiredScope - the admin routes require admin, reading requires read, the others are submissions
+func requiredScope(c *C) model.Scope {
+	switch {
+	case strings.HasPrefix(c.Path(), "/api/v1/admin/") || c.Path() == "/api/v1/admin":
+		return model.ScopeAdmin
+	case c.Method() == iris.MethodGet:
+		return model.ScopeRead
+	default:
+		return model.ScopeSubmit
+	}
+}
+
+// bearer - the token of the bearer authorization header
+func bearer(header string) string {
+	const prefix = "Bearer "
+	if len(header) > len(prefix) && strings.EqualFold(header[:len(prefix)], prefix) {
+		return strings.TrimSpace(header[len(prefix):])
+	}
+
+	return ""
+}
@@ -0,0 +1,289 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/auth"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/score"
+	socre "leaderboard/test/mock/usecase"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_Authorize
+func (h *handlerSuite) Test_Authorize() {
+	mockAuthUsecase := socre.NewMockAuthUsecase(h.ctrl)
+
+	server := &Server{
+		App:                iris.New(),
+		ScoreUsecase:       h.mockScoreUsecase,
+		BoardUsecase:       h.mockBoardUsecase,
+		AuthUsecase:        mockAuthUsecase,
+		IdempotencyUsecase: h.mockIdempotencyUsecase,
+	}
+	server.SetRouter()
+	e := httptest.New(h.T(), server.App, httptest.URL("http://localhost:8080"))
+
+	player := &model.Principal{
+		Subject: "adam",
+		Scopes:  []model.Scope{model.ScopeSubmit, model.ScopeRead},
+	}
+
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test missing credentials",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{}).Return(nil, auth.ErrUnauthenticated).Times(1)
+
+				return e.GET("/api/v1/leaderboard").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": auth.ErrUnauthenticated.Error(),
+				"code":    CodeUnauthorized,
+			},
+		},
+		{
+			name: "test the subject of token replaces the header",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), &score.AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    100,
+				}).Return(&model.ScoreResult{ClientID: "adam", Score: 100, Changed: true}, nil).Times(1)
+
+				return e.POST("/api/v1/score").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithHeader("ClientId", "peter").
+					WithJSON(map[string]interface{}{"score": 100}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"score":    100,
+			},
+		},
+		{
+			name: "test the clientId of body can not replace the subject",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), &score.AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    1e9,
+				}).Return(&model.ScoreResult{ClientID: "adam", Score: 1e9, Changed: true}, nil).Times(1)
+
+				return e.POST("/api/v1/score").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithJSON(map[string]interface{}{"clientId": "victim", "score": 1e9}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+			},
+		},
+		{
+			name: "test the clientId of body can not replace the subject of duplicate submission",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().AddIgnoreDuplicate(gomock.Any(), &score.AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    1e9,
+				}).Return(&model.Entry{EntryID: "e1", ClientID: "adam", Score: 1e9}, nil).Times(1)
+
+				return e.POST("/api/v1/dup/score").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithJSON(map[string]interface{}{"clientId": "victim", "score": 1e9}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+			},
+		},
+		{
+			name: "test the server keeps the header",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{APIKey: "k-racing"}).Return(&model.Principal{
+					Subject: "racing",
+					Scopes:  []model.Scope{model.ScopeSubmit},
+					Server:  true,
+				}, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), &score.AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "peter",
+					Score:    100,
+				}).Return(&model.ScoreResult{ClientID: "peter", Score: 100, Changed: true}, nil).Times(1)
+
+				return e.POST("/api/v1/score").
+					WithHeader("X-Api-Key", "k-racing").
+					WithHeader("ClientId", "peter").
+					WithJSON(map[string]interface{}{"score": 100}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "peter",
+			},
+		},
+		{
+			name: "test the player submits the batch of other player",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+
+				return e.POST("/api/v1/scores:batch").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithJSON(map[string]interface{}{"scores": []interface{}{
+						map[string]interface{}{"clientId": "adam", "score": 100},
+						map[string]interface{}{"clientId": "peter", "score": 100},
+					}}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": ErrForbidden.Error(),
+				"code":    CodeForbidden,
+			},
+		},
+		{
+			name: "test the idempotency key of batch is scoped by the subject",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+				h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, command *idempotency.Request) (json.RawMessage, error) {
+						h.Equal("sub:adam", command.Scope)
+						return json.RawMessage(`{"results":[]}`), nil
+					}).Times(1)
+
+				return e.POST("/api/v1/scores:batch").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithHeader("Idempotency-Key", "k1").
+					WithJSON(map[string]interface{}{"scores": []interface{}{
+						map[string]interface{}{"clientId": "adam", "score": 100},
+					}}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"results": []interface{}{},
+			},
+		},
+		{
+			name: "test the admin route requires admin scope",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(player, nil).Times(1)
+
+				return e.GET("/api/v1/admin/boards").
+					WithHeader("Authorization", "Bearer t0ken").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": ErrForbidden.Error(),
+				"code":    CodeForbidden,
+			},
+		},
+		{
+			name: "test the submission requires submit scope",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{Token: "t0ken"}).Return(&model.Principal{
+					Subject: "adam",
+					Scopes:  []model.Scope{model.ScopeRead},
+				}, nil).Times(1)
+
+				return e.POST("/api/v1/score").
+					WithHeader("Authorization", "Bearer t0ken").
+					WithJSON(map[string]interface{}{"score": 100}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"code": CodeForbidden,
+			},
+		},
+		{
+			name: "test the admin lists boards",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{APIKey: "k-ops"}).Return(&model.Principal{
+					Subject: "ops",
+					Scopes:  []model.Scope{model.ScopeAdmin},
+					Server:  true,
+				}, nil).Times(1)
+				h.mockBoardUsecase.EXPECT().List(gomock.Any()).Return([]*model.Board{}, nil).Times(1)
+
+				return e.GET("/api/v1/admin/boards").
+					WithHeader("X-Api-Key", "k-ops").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"boards": []interface{}{},
+			},
+		},
+		{
+			name: "test the subject of admin is the actor",
+			fn: func() *httpexpect.Object {
+				mockAuthUsecase.EXPECT().Authenticate(gomock.Any(), &auth.Credential{APIKey: "k-ops"}).Return(&model.Principal{
+					Subject: "ops",
+					Scopes:  []model.Scope{model.ScopeAdmin},
+					Server:  true,
+				}, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().RemovePlayer(gomock.Any(), &score.RemovePlayer{
+					Board:    "racing",
+					ClientID: "adam",
+					Reason:   "speed hack",
+				}).DoAndReturn(func(ctx context.Context, command *score.RemovePlayer) error {
+					h.Equal("ops", model.OriginFrom(ctx).Actor)
+					return nil
+				}).Times(1)
+
+				return e.DELETE("/api/v1/admin/boards/racing/players/adam").
+					WithHeader("X-Api-Key", "k-ops").
+					WithQuery("reason", "speed hack").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"status": "ok",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
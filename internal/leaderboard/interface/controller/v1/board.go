@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/usecase/board"
+	"leaderboard/internal/leaderboard/usecase/score"
+)
+
+// CreateBoard -
+func (s *Server) CreateBoard(c *C) {
+	// get body data
+	data := &board.CreateBoard{}
+	if err := c.ReadJSON(data); err != nil {
+		c.E(err)
+		return
+	}
+
+	// usecase
+	b, err := s.BoardUsecase.Create(c.Request().Context(), data)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(b)
+}
+
+// ListBoards -
+func (s *Server) ListBoards(c *C) {
+	boards, err := s.BoardUsecase.List(c.Request().Context())
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(map[string]interface{}{
+		"boards": boards,
+	})
+}
+
+// DeleteBoard -
+func (s *Server) DeleteBoard(c *C) {
+	if err := s.BoardUsecase.Delete(c.Request().Context(), c.Params().Get("board")); err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(nil)
+}
+
+// ResetBoard - archive the standings of board as a new season and clear it
+func (s *Server) ResetBoard(c *C) {
+	result, err := s.ScoreUsecase.ResetLeaderBoard(c.Request().Context(), c.Params().Get("board"))
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(result)
+}
+
+// GetQuarantine - list the submissions rejected by the rules of board
+func (s *Server) GetQuarantine(c *C) {
+	query := &score.GetLeaderBoard{
+		Board:  c.Params().Get("board"),
+		Offset: c.URLParamInt64Default("offset", 0),
+		Limit:  c.URLParamInt64Default("limit", 0),
+		Next:   c.URLParam("next"),
+	}
+
+	page, err := s.ScoreUsecase.GetQuarantine(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
@@ -0,0 +1,324 @@
+package v1
+
+import (
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/board"
+	"leaderboard/internal/leaderboard/usecase/score"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_CreateBoard
+func (h *handlerSuite) Test_CreateBoard() {
+	type args struct {
+		body interface{}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		fn   func(args) *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test create board occur error",
+			args: args{
+				body: &board.CreateBoard{
+					ID: "racing",
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				h.mockBoardUsecase.EXPECT().Create(gomock.Any(), in.body).Return(nil, model.ErrBoardExists).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/admin/boards").
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "board already exists",
+			},
+		},
+		{
+			name: "test create board success",
+			args: args{
+				body: &board.CreateBoard{
+					ID:    "racing",
+					Name:  "Racing",
+					Order: model.OrderAsc,
+					Reset: model.ResetNever,
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				b := &model.Board{
+					ID:    "racing",
+					Name:  "Racing",
+					Order: model.OrderAsc,
+					Reset: model.ResetNever,
+				}
+				h.mockBoardUsecase.EXPECT().Create(gomock.Any(), in.body).Return(b, nil).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/admin/boards").
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"id":    "racing",
+				"name":  "Racing",
+				"order": "asc",
+				"reset": "never",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn(test.args)
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_ListBoards
+func (h *handlerSuite) Test_ListBoards() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test list boards occur error",
+			fn: func() *httpexpect.Object {
+				h.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(nil, errors.New("error")).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/boards").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "error",
+			},
+		},
+		{
+			name: "test list boards success",
+			fn: func() *httpexpect.Object {
+				boards := []*model.Board{
+					{
+						ID:    model.DefaultBoard,
+						Name:  "Leaderboard",
+						Order: model.OrderDesc,
+						Reset: model.ResetTTL,
+					},
+				}
+				h.mockBoardUsecase.EXPECT().List(gomock.Any()).Return(boards, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/boards").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"boards": []*model.Board{
+					{
+						ID:    model.DefaultBoard,
+						Name:  "Leaderboard",
+						Order: model.OrderDesc,
+						Reset: model.ResetTTL,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_DeleteBoard
+func (h *handlerSuite) Test_DeleteBoard() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test delete board occur error",
+			fn: func() *httpexpect.Object {
+				h.mockBoardUsecase.EXPECT().Delete(gomock.Any(), "unknown").Return(model.ErrBoardNotFound).Times(1)
+
+				return h.mockHTTP.DELETE("/api/v1/admin/boards/unknown").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "board not found",
+			},
+		},
+		{
+			name: "test delete board success",
+			fn: func() *httpexpect.Object {
+				h.mockBoardUsecase.EXPECT().Delete(gomock.Any(), "racing").Return(nil).Times(1)
+
+				return h.mockHTTP.DELETE("/api/v1/admin/boards/racing").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"status": "ok",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_ResetBoard
+func (h *handlerSuite) Test_ResetBoard() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test reset board occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().ResetLeaderBoard(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/admin/boards/unknown/reset").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "board not found",
+			},
+		},
+		{
+			name: "test reset board success",
+			fn: func() *httpexpect.Object {
+				result := &model.ResetResult{
+					Board:   "racing",
+					Season:  3,
+					Players: 42,
+					Entries: 7,
+				}
+				h.mockScoreUsecase.EXPECT().ResetLeaderBoard(gomock.Any(), "racing").Return(result, nil).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/admin/boards/racing/reset").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"board":   "racing",
+				"season":  3,
+				"players": 42,
+				"entries": 7,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetQuarantine
+func (h *handlerSuite) Test_GetQuarantine() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test get quarantine occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetQuarantine(gomock.Any(), &score.GetLeaderBoard{Board: "unknown"}).Return(nil, model.ErrBoardNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/boards/unknown/quarantine").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "board not found",
+			},
+		},
+		{
+			name: "test get quarantine success",
+			fn: func() *httpexpect.Object {
+				page := &model.QuarantinePage{
+					Submissions: []*model.Quarantined{
+						{ClientID: "adam", Score: 5000, Reason: "score is out of range", CreatedAt: 1760000000},
+					},
+					Total: 1,
+				}
+				h.mockScoreUsecase.EXPECT().GetQuarantine(gomock.Any(), &score.GetLeaderBoard{Board: "ranked", Limit: 20}).Return(page, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/boards/ranked/quarantine").
+					WithQuery("limit", 20).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"submissions": []interface{}{
+					map[string]interface{}{"clientId": "adam", "score": 5000, "reason": "score is out of range", "createdAt": 1760000000},
+				},
+				"total": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
@@ -1,16 +1,46 @@
 package v1
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/auth"
+	"leaderboard/internal/leaderboard/usecase/board"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/player"
 	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/signature"
+	"leaderboard/internal/leaderboard/usecase/team"
+	"leaderboard/pkg/response"
 
 	"github.com/kataras/iris/v12"
 )
 
+const (
+	// MaxMetadataSize - the max bytes of score metadata
+	MaxMetadataSize = 1024
+)
+
+var (
+	// ErrInvalidMetadata -
+	ErrInvalidMetadata = errors.New("metadata must be a JSON object within 1024 bytes")
+)
+
 type Server struct {
-	App          *iris.Application
-	ScoreUsecase score.ScoreUsecase
+	App                *iris.Application
+	ScoreUsecase       score.ScoreUsecase
+	BoardUsecase       board.BoardUsecase
+	PlayerUsecase      player.PlayerUsecase
+	TeamUsecase        team.TeamUsecase
+	IdempotencyUsecase idempotency.IdempotencyUsecase
+
+	// SignatureUsecase the submissions must be signed when it is set
+	SignatureUsecase signature.SignatureUsecase
+
+	// AuthUsecase the requests must be authenticated when it is set
+	AuthUsecase auth.AuthUsecase
 }
 
 // Version used to get version, and ping pong check
@@ -25,28 +55,98 @@ func (s *Server) SaveScore(c *C) {
 	// get clientId from head
 	clientId := c.Request().Header.Get("ClientId")
 
-	// check clientId is exist
-	if clientId == "" {
+	// check clientId is valid
+	if !model.ValidID(clientId) {
 		c.E(errors.New("bad request"))
 		return
 	}
 
-	// get body data
-	data := &score.AddScore{
-		ClientID: clientId,
-	}
+	// get body data, the client is always the one of header, the clientId in body is ignored
+	data := &score.AddScore{}
 	if err := c.ReadJSON(data); err != nil {
 		c.E(err)
 		return
 	}
+	data.Board = c.Board()
+	data.ClientID = clientId
+
+	// check metadata is a bounded JSON object
+	if !validMetadata(data.Metadata) {
+		c.E(ErrInvalidMetadata)
+		return
+	}
+	if string(data.Metadata) == "null" {
+		data.Metadata = nil
+	}
 
 	// usecase
-	if err := s.ScoreUsecase.Add(c.Request().Context(), data); err != nil {
+	s.idempotent(c, clientId, data, func() (interface{}, error) {
+		return s.ScoreUsecase.Add(c.Request().Context(), data)
+	})
+}
+
+// SaveScores - save the scores of many clients, the clientId of each score is in the body
+func (s *Server) SaveScores(c *C) {
+	// get body data
+	data := &score.AddScores{}
+	if err := c.ReadJSON(data); err != nil {
 		c.E(err)
 		return
 	}
+	data.Board = c.Board()
 
-	c.R(nil)
+	// check metadata is a bounded JSON object, the batch is malformed when any metadata is not.
+	// A player only submits its own scores, the game servers submit on behalf of any player
+	p := c.Principal()
+	for _, item := range data.Scores {
+		if item == nil {
+			c.E(errors.New("bad request"))
+			return
+		}
+
+		if p != nil && !p.Server && item.ClientID != p.Subject {
+			c.E(response.WithCode(CodeForbidden, ErrForbidden))
+			return
+		}
+
+		if !validMetadata(item.Metadata) {
+			c.E(ErrInvalidMetadata)
+			return
+		}
+		if string(item.Metadata) == "null" {
+			item.Metadata = nil
+		}
+	}
+
+	// usecase
+	s.idempotent(c, s.batchScope(c), data, func() (interface{}, error) {
+		results, err := s.ScoreUsecase.AddBatch(c.Request().Context(), data)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{
+			"results": results,
+		}, nil
+	})
+}
+
+// batchScope - the scope of the idempotency key of batch, the clients are in the body, so the key is scoped by
+// the authenticated subject, or the game of signature, and by the valid ClientId header when neither is used
+func (s *Server) batchScope(c *C) string {
+	if p := c.Principal(); p != nil {
+		return "sub:" + p.Subject
+	}
+
+	if s.SignatureUsecase != nil {
+		return "game:" + c.GetHeader("X-Game-Id")
+	}
+
+	if id := c.GetHeader("ClientId"); model.ValidID(id) {
+		return id
+	}
+
+	return ""
 }
 
 // SaveScoreIgnoreDuplicate
@@ -54,39 +154,173 @@ func (s *Server) SaveScoreIgnoreDuplicate(c *C) {
 	// get clientId from head
 	clientId := c.Request().Header.Get("ClientId")
 
-	// check clientId is exist
-	if clientId == "" {
+	// check clientId is valid
+	if !model.ValidID(clientId) {
 		c.E(errors.New("bad request"))
 		return
 	}
 
-	// get body data
-	data := &score.AddScore{
-		ClientID: clientId,
-	}
+	// get body data, the client is always the one of header, the clientId in body is ignored
+	data := &score.AddScore{}
 	if err := c.ReadJSON(data); err != nil {
 		c.E(err)
 		return
 	}
+	data.Board = c.Board()
+	data.ClientID = clientId
 
-	// usecase
-	if err := s.ScoreUsecase.AddIgnoreDuplicate(c.Request().Context(), data); err != nil {
-		c.E(err)
+	// check metadata is a bounded JSON object
+	if !validMetadata(data.Metadata) {
+		c.E(ErrInvalidMetadata)
 		return
 	}
+	if string(data.Metadata) == "null" {
+		data.Metadata = nil
+	}
 
-	c.R(nil)
+	// usecase
+	s.idempotent(c, clientId, data, func() (interface{}, error) {
+		return s.ScoreUsecase.AddIgnoreDuplicate(c.Request().Context(), data)
+	})
 }
 
 // GetLeaderBoard
 func (s *Server) GetLeaderBoard(c *C) {
-	scores, err := s.ScoreUsecase.GetLeaderBoard(c.Request().Context())
+	query := &score.GetLeaderBoard{
+		Board:   c.Board(),
+		Window:  model.Window(c.URLParam("window")),
+		Segment: c.URLParam("segment"),
+		Offset:  c.URLParamInt64Default("offset", 0),
+		Limit:   c.URLParamInt64Default("limit", 0),
+		Next:    c.URLParam("next"),
+	}
+
+	page, err := s.ScoreUsecase.GetLeaderBoard(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
+
+// GetEntries
+func (s *Server) GetEntries(c *C) {
+	query := &score.GetLeaderBoard{
+		Board:  c.Board(),
+		Offset: c.URLParamInt64Default("offset", 0),
+		Limit:  c.URLParamInt64Default("limit", 0),
+		Next:   c.URLParam("next"),
+	}
+
+	page, err := s.ScoreUsecase.GetEntries(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
+
+// GetPlayerRank
+func (s *Server) GetPlayerRank(c *C) {
+	query := &score.GetPlayer{
+		Board:    c.Board(),
+		Window:   model.Window(c.URLParam("window")),
+		Segment:  c.URLParam("segment"),
+		ClientID: c.Params().Get("clientId"),
+	}
+
+	rank, err := s.ScoreUsecase.GetPlayerRank(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(rank)
+}
+
+// GetAroundPlayer
+func (s *Server) GetAroundPlayer(c *C) {
+	query := &score.GetPlayer{
+		Board:    c.Board(),
+		Window:   model.Window(c.URLParam("window")),
+		Segment:  c.URLParam("segment"),
+		ClientID: c.Params().Get("clientId"),
+		Radius:   c.URLParamInt64Default("radius", 5),
+	}
+
+	scores, err := s.ScoreUsecase.GetAroundPlayer(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(map[string]interface{}{
+		"players": scores,
+	})
+}
+
+// GetFriends
+func (s *Server) GetFriends(c *C) {
+	query := &score.GetPlayer{
+		Board:    c.Board(),
+		Window:   model.Window(c.URLParam("window")),
+		Segment:  c.URLParam("segment"),
+		ClientID: c.Params().Get("clientId"),
+	}
+
+	scores, err := s.ScoreUsecase.GetFriends(c.Request().Context(), query)
 	if err != nil {
 		c.E(err)
 		return
 	}
 
 	c.R(map[string]interface{}{
-		"topPlayers": scores,
+		"players": scores,
 	})
 }
+
+// ListSeasons
+func (s *Server) ListSeasons(c *C) {
+	seasons, err := s.ScoreUsecase.ListSeasons(c.Request().Context(), c.Board())
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(map[string]interface{}{
+		"seasons": seasons,
+	})
+}
+
+// GetSeason
+func (s *Server) GetSeason(c *C) {
+	query := &score.GetSeason{
+		GetLeaderBoard: score.GetLeaderBoard{
+			Board:  c.Board(),
+			Offset: c.URLParamInt64Default("offset", 0),
+			Limit:  c.URLParamInt64Default("limit", 0),
+			Next:   c.URLParam("next"),
+		},
+		Season: c.Params().GetInt64Default("id", 0),
+	}
+
+	page, err := s.ScoreUsecase.GetSeason(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
+
+// validMetadata - metadata is optional, or a JSON object within MaxMetadataSize
+func validMetadata(m json.RawMessage) bool {
+	m = bytes.TrimSpace(m)
+	if len(m) == 0 || string(m) == "null" {
+		return true
+	}
+
+	return len(m) <= MaxMetadataSize && m[0] == '{'
+}
@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"encoding/json"
 	"errors"
 	"leaderboard/internal/leaderboard/domain/model"
 	"leaderboard/internal/leaderboard/usecase/score"
 	socre "leaderboard/test/mock/usecase"
+	"strings"
 	"testing"
 
 	"github.com/gavv/httpexpect"
@@ -17,10 +19,14 @@ import (
 
 type handlerSuite struct {
 	suite.Suite
-	ctrl             *gomock.Controller
-	mockScoreUsecase *socre.MockScoreUsecase
-	server           *Server
-	mockHTTP         *httpexpect.Expect
+	ctrl                   *gomock.Controller
+	mockScoreUsecase       *socre.MockScoreUsecase
+	mockBoardUsecase       *socre.MockBoardUsecase
+	mockPlayerUsecase      *socre.MockPlayerUsecase
+	mockTeamUsecase        *socre.MockTeamUsecase
+	mockIdempotencyUsecase *socre.MockIdempotencyUsecase
+	server                 *Server
+	mockHTTP               *httpexpect.Expect
 }
 
 // SetupTest
@@ -28,10 +34,18 @@ func (t *handlerSuite) SetupSuite() {
 	t.ctrl = gomock.NewController(t.T())
 
 	t.mockScoreUsecase = socre.NewMockScoreUsecase(t.ctrl)
+	t.mockBoardUsecase = socre.NewMockBoardUsecase(t.ctrl)
+	t.mockPlayerUsecase = socre.NewMockPlayerUsecase(t.ctrl)
+	t.mockTeamUsecase = socre.NewMockTeamUsecase(t.ctrl)
+	t.mockIdempotencyUsecase = socre.NewMockIdempotencyUsecase(t.ctrl)
 
 	t.server = &Server{
-		App:          iris.New(),
-		ScoreUsecase: t.mockScoreUsecase,
+		App:                iris.New(),
+		ScoreUsecase:       t.mockScoreUsecase,
+		BoardUsecase:       t.mockBoardUsecase,
+		PlayerUsecase:      t.mockPlayerUsecase,
+		TeamUsecase:        t.mockTeamUsecase,
+		IdempotencyUsecase: t.mockIdempotencyUsecase,
 	}
 
 	t.server.SetRouter()
@@ -137,22 +151,158 @@ func (h *handlerSuite) Test_SaveScore() {
 				},
 			},
 			fn: func(in args) *httpexpect.Object {
-				var e error
 				command := in.body.(*score.AddScore)
 				command.ClientID = "peter"
+				command.Board = model.DefaultBoard
 
-				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(e).Times(1)
+				result := &model.ScoreResult{
+					ClientID: command.ClientID,
+					Score:    command.Score,
+					Changed:  true,
+				}
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(result, nil).Times(1)
 
+				return h.mockHTTP.POST("/api/v1/score").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "peter",
+				"score":    100.2,
+				"changed":  true,
+			},
+		},
+		{
+			name: "test metadata is not object case",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: map[string]interface{}{
+					"Score":    1,
+					"Metadata": []int{1, 2},
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
 				return h.mockHTTP.POST("/api/v1/score").
 					WithHeaders(in.headers).
 					WithJSON(in.body).
 					Expect().
 					Status(httptest.StatusOK).
 					JSON().Object().
-					ContainsKey("status")
+					ContainsKey("status").
+					Value("status").Object().
+					ContainsKey("message")
 			},
 			want: map[string]interface{}{
-				"status": "ok",
+				"message": ErrInvalidMetadata.Error(),
+			},
+		},
+		{
+			name: "test metadata is too large case",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: map[string]interface{}{
+					"Score": 1,
+					"Metadata": map[string]string{
+						"replayId": strings.Repeat("a", MaxMetadataSize),
+					},
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				return h.mockHTTP.POST("/api/v1/score").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object().
+					ContainsKey("message")
+			},
+			want: map[string]interface{}{
+				"message": ErrInvalidMetadata.Error(),
+			},
+		},
+		{
+			name: "test save score with metadata success",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: map[string]interface{}{
+					"Score": 12,
+					"Metadata": map[string]interface{}{
+						"level": 3,
+					},
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				command := &score.AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    12,
+					Metadata: json.RawMessage(`{"level":3}`),
+				}
+
+				result := &model.ScoreResult{
+					ClientID: command.ClientID,
+					Score:    command.Score,
+					Changed:  true,
+				}
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(result, nil).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/score").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"score":    12,
+				"changed":  true,
+			},
+		},
+		{
+			name: "test save score to specified board success",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "peter",
+				},
+				body: &score.AddScore{
+					Score: 100.2,
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				command := in.body.(*score.AddScore)
+				command.ClientID = "peter"
+				command.Board = "racing"
+
+				result := &model.ScoreResult{
+					ClientID: command.ClientID,
+					Score:    command.Score,
+					Changed:  true,
+				}
+				h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(result, nil).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/boards/racing/score").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "peter",
+				"score":    100.2,
+				"changed":  true,
 			},
 		},
 	}
@@ -168,6 +318,96 @@ func (h *handlerSuite) Test_SaveScore() {
 	}
 }
 
+// Test_SaveScores
+func (h *handlerSuite) Test_SaveScores() {
+	tests := []struct {
+		name string
+		body interface{}
+		fn   func(body interface{}) *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test metadata is not object case",
+			body: `{"scores": [{"clientId": "adam", "score": 10, "metadata": [1]}]}`,
+			fn: func(body interface{}) *httpexpect.Object {
+				return h.mockHTTP.POST("/api/v1/scores:batch").
+					WithHeader("Content-Type", "application/json").
+					WithBytes([]byte(body.(string))).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": ErrInvalidMetadata.Error(),
+			},
+		},
+		{
+			name: "test save scores success",
+			body: &score.AddScores{
+				Scores: []*score.AddScore{
+					{ClientID: "adam", Score: 100},
+					{Score: 90},
+				},
+			},
+			fn: func(body interface{}) *httpexpect.Object {
+				command := &score.AddScores{
+					Board: "racing",
+					Scores: []*score.AddScore{
+						{ClientID: "adam", Score: 100},
+						{Score: 90},
+					},
+				}
+
+				results := []*model.BatchResult{
+					{ClientID: "adam", Score: 100, Changed: true},
+					{Error: score.ErrInvalidClientID.Error()},
+				}
+				h.mockScoreUsecase.EXPECT().AddBatch(gomock.Any(), command).Return(results, nil).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/boards/racing/scores:batch").
+					WithJSON(body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"results": []interface{}{
+					map[string]interface{}{"clientId": "adam", "score": 100, "changed": true},
+					map[string]interface{}{"clientId": "", "score": 0, "changed": false, "error": "invalid client id"},
+				},
+			},
+		},
+		{
+			name: "test save scores error case",
+			body: &score.AddScores{},
+			fn: func(body interface{}) *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().AddBatch(gomock.Any(), &score.AddScores{Board: model.DefaultBoard}).Return(nil, score.ErrInvalidBatch).Times(1)
+
+				return h.mockHTTP.POST("/api/v1/scores:batch").
+					WithJSON(body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": score.ErrInvalidBatch.Error(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn(test.body)
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
 // Test_SaveScoreIgnoreDuplicate
 func (h *handlerSuite) Test_SaveScoreIgnoreDuplicate() {
 	type args struct {
@@ -253,22 +493,30 @@ func (h *handlerSuite) Test_SaveScoreIgnoreDuplicate() {
 				},
 			},
 			fn: func(in args) *httpexpect.Object {
-				var e error
 				command := in.body.(*score.AddScore)
 				command.ClientID = "adam"
+				command.Board = model.DefaultBoard
 
-				h.mockScoreUsecase.EXPECT().AddIgnoreDuplicate(gomock.Any(), command).Return(e).Times(1)
+				entry := &model.Entry{
+					EntryID:   "3",
+					ClientID:  command.ClientID,
+					Score:     command.Score,
+					CreatedAt: 1664553600,
+				}
+				h.mockScoreUsecase.EXPECT().AddIgnoreDuplicate(gomock.Any(), command).Return(entry, nil).Times(1)
 
 				return h.mockHTTP.POST("/api/v1/dup/score").
 					WithHeaders(in.headers).
 					WithJSON(in.body).
 					Expect().
 					Status(httptest.StatusOK).
-					JSON().Object().
-					ContainsKey("status")
+					JSON().Object()
 			},
 			want: map[string]interface{}{
-				"status": "ok",
+				"entryId":   "3",
+				"clientId":  "adam",
+				"score":     100.2,
+				"createdAt": 1664553600,
 			},
 		},
 	}
@@ -300,7 +548,9 @@ func (h *handlerSuite) Test_GetLeaderBoard() {
 			name: "test GetLeaderBoard occur error",
 			args: args{},
 			fn: func(args) *httpexpect.Object {
-				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any()).Return(nil, errors.New("error")).Times(1)
+				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any(), &score.GetLeaderBoard{
+					Board: model.DefaultBoard,
+				}).Return(nil, errors.New("error")).Times(1)
 
 				return h.mockHTTP.GET("/api/v1/leaderboard").
 					Expect().
@@ -317,34 +567,175 @@ func (h *handlerSuite) Test_GetLeaderBoard() {
 			name: "test GetLeaderBoard success",
 			args: args{},
 			fn: func(args) *httpexpect.Object {
-				scores := []*model.Score{
+				page := &model.ScorePage{
+					Scores: []*model.Score{
+						{
+							ClientID: "adam",
+							Score:    100.3,
+							Rank:     1,
+						},
+						{
+							ClientID: "peter",
+							Score:    10.1,
+							Rank:     2,
+						},
+					},
+					Total: 2,
+				}
+				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any(), &score.GetLeaderBoard{
+					Board: model.DefaultBoard,
+				}).Return(page, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"topPlayers": []*model.Score{
 					{
 						ClientID: "adam",
 						Score:    100.3,
+						Rank:     1,
 					},
 					{
 						ClientID: "peter",
 						Score:    10.1,
+						Rank:     2,
 					},
+				},
+				"total": 2,
+			},
+		},
+		{
+			name: "test GetLeaderBoard with page success",
+			args: args{},
+			fn: func(args) *httpexpect.Object {
+				page := &model.ScorePage{
+					Scores: []*model.Score{
+						{
+							ClientID: "adam",
+							Score:    100.3,
+							Rank:     3,
+						},
+					},
+					Total: 5,
+					Next:  "NA",
 				}
-				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any()).Return(scores, nil).Times(1)
+				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any(), &score.GetLeaderBoard{
+					Board:  "racing",
+					Window: model.WindowMonthly,
+					Offset: 2,
+					Limit:  1,
+				}).Return(page, nil).Times(1)
 
-				return h.mockHTTP.GET("/api/v1/leaderboard").
+				return h.mockHTTP.GET("/api/v1/boards/racing/leaderboard").
+					WithQuery("window", "monthly").
+					WithQuery("offset", 2).
+					WithQuery("limit", 1).
 					Expect().
 					Status(httptest.StatusOK).
 					JSON().Object()
 			},
 			want: map[string]interface{}{
-				"topPlayers": []*model.Score{
+				"total": 5,
+				"next":  "NA",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn(test.args)
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetEntries
+func (h *handlerSuite) Test_GetEntries() {
+	type args struct {
+		headers map[string]string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		fn   func(args) *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetEntries occur error",
+			args: args{},
+			fn: func(args) *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetEntries(gomock.Any(), &score.GetLeaderBoard{
+					Board: model.DefaultBoard,
+				}).Return(nil, errors.New("error")).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/entries").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "error",
+			},
+		},
+		{
+			name: "test GetEntries success",
+			args: args{},
+			fn: func(args) *httpexpect.Object {
+				page := &model.EntryPage{
+					Entries: []*model.Entry{
+						{
+							EntryID:  "5",
+							ClientID: "adam",
+							Score:    100.3,
+							Rank:     1,
+						},
+						{
+							EntryID:  "2",
+							ClientID: "adam",
+							Score:    10.1,
+							Rank:     2,
+						},
+					},
+					Total: 3,
+					Next:  "Ag",
+				}
+				h.mockScoreUsecase.EXPECT().GetEntries(gomock.Any(), &score.GetLeaderBoard{
+					Board: "racing",
+					Limit: 2,
+				}).Return(page, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/racing/leaderboard/entries").
+					WithQuery("limit", 2).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"entries": []*model.Entry{
 					{
+						EntryID:  "5",
 						ClientID: "adam",
 						Score:    100.3,
+						Rank:     1,
 					},
 					{
-						ClientID: "peter",
+						EntryID:  "2",
+						ClientID: "adam",
 						Score:    10.1,
+						Rank:     2,
 					},
 				},
+				"total": 3,
+				"next":  "Ag",
 			},
 		},
 	}
@@ -359,3 +750,363 @@ func (h *handlerSuite) Test_GetLeaderBoard() {
 		})
 	}
 }
+
+// Test_ListSeasons
+func (h *handlerSuite) Test_ListSeasons() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test ListSeasons occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().ListSeasons(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/unknown/leaderboard/seasons").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": model.ErrBoardNotFound.Error(),
+			},
+		},
+		{
+			name: "test ListSeasons success",
+			fn: func() *httpexpect.Object {
+				seasons := []*model.Season{
+					{ID: 2, Board: model.DefaultBoard, EndedAt: 1664557200, Total: 5},
+					{ID: 1, Board: model.DefaultBoard, EndedAt: 1664553600, Total: 8},
+				}
+				h.mockScoreUsecase.EXPECT().ListSeasons(gomock.Any(), model.DefaultBoard).Return(seasons, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/seasons").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"seasons": []*model.Season{
+					{ID: 2, Board: model.DefaultBoard, EndedAt: 1664557200, Total: 5},
+					{ID: 1, Board: model.DefaultBoard, EndedAt: 1664553600, Total: 8},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetSeason
+func (h *handlerSuite) Test_GetSeason() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetSeason occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetSeason(gomock.Any(), &score.GetSeason{
+					GetLeaderBoard: score.GetLeaderBoard{
+						Board: model.DefaultBoard,
+					},
+					Season: 9,
+				}).Return(nil, model.ErrSeasonNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/seasons/9").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": model.ErrSeasonNotFound.Error(),
+			},
+		},
+		{
+			name: "test GetSeason success",
+			fn: func() *httpexpect.Object {
+				page := &model.ScorePage{
+					Scores: []*model.Score{
+						{ClientID: "adam", Score: 100, Rank: 1},
+					},
+					Total: 3,
+					Next:  "AQ",
+				}
+				h.mockScoreUsecase.EXPECT().GetSeason(gomock.Any(), &score.GetSeason{
+					GetLeaderBoard: score.GetLeaderBoard{
+						Board: "racing",
+						Limit: 1,
+					},
+					Season: 2,
+				}).Return(page, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/racing/leaderboard/seasons/2").
+					WithQuery("limit", 1).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"topPlayers": []*model.Score{
+					{ClientID: "adam", Score: 100, Rank: 1},
+				},
+				"total": 3,
+				"next":  "AQ",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetPlayerRank
+func (h *handlerSuite) Test_GetPlayerRank() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetPlayerRank occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetPlayerRank(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+				}).Return(nil, model.ErrPlayerNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/players/adam").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "player not found",
+			},
+		},
+		{
+			name: "test GetPlayerRank success",
+			fn: func() *httpexpect.Object {
+				rank := &model.PlayerRank{
+					ClientID:   "adam",
+					Score:      100.3,
+					Rank:       1,
+					Total:      4,
+					Percentile: 100,
+				}
+				h.mockScoreUsecase.EXPECT().GetPlayerRank(gomock.Any(), &score.GetPlayer{
+					Board:    "racing",
+					Window:   model.WindowWeekly,
+					ClientID: "adam",
+				}).Return(rank, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/racing/leaderboard/players/adam").
+					WithQuery("window", "weekly").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId":   "adam",
+				"score":      100.3,
+				"rank":       1,
+				"total":      4,
+				"percentile": 100,
+			},
+		},
+		{
+			name: "test GetPlayerRank of segment success",
+			fn: func() *httpexpect.Object {
+				rank := &model.PlayerRank{
+					ClientID:   "adam",
+					Score:      100.3,
+					Rank:       2,
+					Total:      4,
+					Percentile: 75,
+				}
+				h.mockScoreUsecase.EXPECT().GetPlayerRank(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					Segment:  "country:TW",
+					ClientID: "adam",
+				}).Return(rank, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/players/adam").
+					WithQuery("segment", "country:TW").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"rank":     2,
+				"total":    4,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetAroundPlayer
+func (h *handlerSuite) Test_GetAroundPlayer() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetAroundPlayer occur error",
+			fn: func() *httpexpect.Object {
+				var radius int64 = 5
+				h.mockScoreUsecase.EXPECT().GetAroundPlayer(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Radius:   radius,
+				}).Return(nil, model.ErrPlayerNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/around/adam").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "player not found",
+			},
+		},
+		{
+			name: "test GetAroundPlayer success",
+			fn: func() *httpexpect.Object {
+				var radius int64 = 1
+				scores := []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 5},
+					{ClientID: "peter", Score: 20, Rank: 6},
+				}
+				h.mockScoreUsecase.EXPECT().GetAroundPlayer(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					Window:   model.WindowDaily,
+					ClientID: "peter",
+					Radius:   radius,
+				}).Return(scores, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/around/peter").
+					WithQuery("radius", 1).
+					WithQuery("window", "daily").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"players": []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 5},
+					{ClientID: "peter", Score: 20, Rank: 6},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetFriends
+func (h *handlerSuite) Test_GetFriends() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetFriends occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetFriends(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+				}).Return(nil, model.ErrBoardNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/friends/adam").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": model.ErrBoardNotFound.Error(),
+			},
+		},
+		{
+			name: "test GetFriends success",
+			fn: func() *httpexpect.Object {
+				scores := []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 1},
+					{ClientID: "peter", Score: 20, Rank: 2},
+				}
+				h.mockScoreUsecase.EXPECT().GetFriends(gomock.Any(), &score.GetPlayer{
+					Board:    "racing",
+					Window:   model.WindowWeekly,
+					ClientID: "peter",
+				}).Return(scores, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/racing/leaderboard/friends/peter").
+					WithQuery("window", "weekly").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"players": []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 1},
+					{ClientID: "peter", Score: 20, Rank: 2},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
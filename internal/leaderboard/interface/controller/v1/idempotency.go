@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/score"
+)
+
+// idempotent - respond the result of fn, fn runs only once for the Idempotency-Key of request,
+// and the repeated request gets the result of the first one
+func (s *Server) idempotent(c *C, scope string, command interface{}, fn func() (interface{}, error)) {
+	key := c.GetHeader("Idempotency-Key")
+	if key == "" {
+		result, err := fn()
+		if err != nil {
+			c.E(err)
+			return
+		}
+
+		c.R(result)
+		return
+	}
+
+	// the route and the parsed body identify the request, the board is in the route
+	body, err := json.Marshal(command)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	ctx := c.Request().Context()
+	request := &idempotency.Request{
+		Key:     key,
+		Scope:   scope,
+		Payload: append([]byte(c.Path()+"\n"), body...),
+	}
+
+	replayed, err := s.IdempotencyUsecase.Begin(ctx, request)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	if replayed != nil {
+		c.Header("Idempotent-Replayed", "true")
+		c.R(replayed)
+		return
+	}
+
+	result, err := fn()
+	if err != nil {
+		var written *score.WriteError
+		if errors.As(err, &written) {
+			// the score is written before the failure, so the retry would write it again, the failure is recorded instead
+			_ = s.IdempotencyUsecase.Fail(ctx, request, err)
+		} else {
+			// nothing is written, so the key is released for the retry, it expires with the lease when releasing fails
+			_ = s.IdempotencyUsecase.Abort(ctx, request)
+		}
+
+		c.E(err)
+		return
+	}
+
+	// the score is written, so respond it even when the result can not be recorded
+	_ = s.IdempotencyUsecase.Complete(ctx, request, result)
+
+	c.R(result)
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/idempotency"
+	"leaderboard/internal/leaderboard/usecase/score"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_Idempotent
+func (h *handlerSuite) Test_Idempotent() {
+	command := &score.AddScore{
+		Board:    model.DefaultBoard,
+		ClientID: "adam",
+		Score:    10,
+	}
+	body, _ := json.Marshal(command)
+	request := &idempotency.Request{
+		Key:     "k1",
+		Scope:   "adam",
+		Payload: append([]byte("/api/v1/score\n"), body...),
+	}
+	result := &model.ScoreResult{ClientID: "adam", Score: 10, Changed: true}
+
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Response
+		want map[string]interface{}
+	}{
+		{
+			name: "test first request case",
+			fn: func() *httpexpect.Response {
+				gomock.InOrder(
+					h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), request).Return(nil, nil).Times(1),
+					h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(result, nil).Times(1),
+					h.mockIdempotencyUsecase.EXPECT().Complete(gomock.Any(), request, result).Return(nil).Times(1),
+				)
+
+				return h.post()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"score":    10,
+				"changed":  true,
+			},
+		},
+		{
+			name: "test repeated request case",
+			fn: func() *httpexpect.Response {
+				h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), request).Return(json.RawMessage(`{"clientId":"adam","score":10,"changed":true}`), nil).Times(1)
+
+				resp := h.post()
+				resp.Header("Idempotent-Replayed").Equal("true")
+
+				return resp
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"score":    10,
+				"changed":  true,
+			},
+		},
+		{
+			name: "test failed request releases the key case",
+			fn: func() *httpexpect.Response {
+				gomock.InOrder(
+					h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), request).Return(nil, nil).Times(1),
+					h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(nil, errors.New("board not found")).Times(1),
+					h.mockIdempotencyUsecase.EXPECT().Abort(gomock.Any(), request).Return(nil).Times(1),
+				)
+
+				return h.post()
+			},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"message": "board not found"},
+			},
+		},
+		{
+			name: "test request failed after write records the failure case",
+			fn: func() *httpexpect.Response {
+				failure := &score.WriteError{Err: errors.New("failed")}
+				gomock.InOrder(
+					h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), request).Return(nil, nil).Times(1),
+					h.mockScoreUsecase.EXPECT().Add(gomock.Any(), command).Return(nil, failure).Times(1),
+					h.mockIdempotencyUsecase.EXPECT().Fail(gomock.Any(), request, failure).Return(nil).Times(1),
+				)
+
+				return h.post()
+			},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"message": "failed"},
+			},
+		},
+		{
+			name: "test request in progress case",
+			fn: func() *httpexpect.Response {
+				h.mockIdempotencyUsecase.EXPECT().Begin(gomock.Any(), request).Return(nil, idempotency.ErrInProgress).Times(1)
+
+				return h.post()
+			},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"message": idempotency.ErrInProgress.Error()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			expect := test.fn().JSON().Object()
+			for k, w := range test.want {
+				if status, ok := w.(map[string]interface{}); ok {
+					expect.Value(k).Object().ValueEqual("message", status["message"])
+					continue
+				}
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// post - submit the score of adam with Idempotency-Key
+func (h *handlerSuite) post() *httpexpect.Response {
+	return h.mockHTTP.POST("/api/v1/score").
+		WithHeader("ClientId", "adam").
+		WithHeader("Idempotency-Key", "k1").
+		WithJSON(map[string]interface{}{"score": 10}).
+		Expect().
+		Status(httptest.StatusOK)
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"leaderboard/internal/leaderboard/domain/model"
 	"leaderboard/pkg/response"
 
 	"github.com/kataras/iris/v12"
@@ -22,6 +23,11 @@ func HandleFunc(handler func(*C)) func(iris.Context) {
 	}
 }
 
+// Board get board id from path, the default board is used when it is not specified
+func (c *C) Board() string {
+	return c.Params().GetStringDefault("board", model.DefaultBoard)
+}
+
 // Cros for iris cros middleware
 func Cros() context.Handler {
 	return func(ctx context.Context) {
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/usecase/score"
+)
+
+// RemovePlayer - remove the player from board, the reason is in the query
+func (s *Server) RemovePlayer(c *C) {
+	command := &score.RemovePlayer{
+		Board:    c.Params().Get("board"),
+		ClientID: c.Params().Get("clientId"),
+		Reason:   c.URLParam("reason"),
+	}
+
+	if err := s.ScoreUsecase.RemovePlayer(c.Request().Context(), command); err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(nil)
+}
+
+// SetPlayerScore - set the score of player, or adjust it by delta
+func (s *Server) SetPlayerScore(c *C) {
+	command := &score.SetScore{}
+	if err := c.ReadJSON(command); err != nil {
+		c.E(err)
+		return
+	}
+	command.Board = c.Params().Get("board")
+	command.ClientID = c.Params().Get("clientId")
+
+	result, err := s.ScoreUsecase.SetScore(c.Request().Context(), command)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(result)
+}
+
+// BanPlayer - ban the client from submitting scores
+func (s *Server) BanPlayer(c *C) {
+	command := &score.BanPlayer{}
+	if err := c.ReadJSON(command); err != nil {
+		c.E(err)
+		return
+	}
+	command.ClientID = c.Params().Get("clientId")
+
+	ban, err := s.ScoreUsecase.Ban(c.Request().Context(), command)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(ban)
+}
+
+// UnbanPlayer - lift the ban of client, the reason is in the query
+func (s *Server) UnbanPlayer(c *C) {
+	command := &score.BanPlayer{
+		ClientID: c.Params().Get("clientId"),
+		Reason:   c.URLParam("reason"),
+	}
+
+	if err := s.ScoreUsecase.Unban(c.Request().Context(), command); err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(nil)
+}
+
+// GetAudit - query the audit log by player and time range
+func (s *Server) GetAudit(c *C) {
+	query := &score.GetAudit{
+		ClientID: c.URLParam("clientId"),
+		From:     c.URLParamInt64Default("from", 0),
+		To:       c.URLParamInt64Default("to", 0),
+		Limit:    c.URLParamInt64Default("limit", 0),
+		Next:     c.URLParam("next"),
+	}
+
+	page, err := s.ScoreUsecase.GetAudit(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
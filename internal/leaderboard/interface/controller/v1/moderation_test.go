@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/score"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_Moderation
+func (h *handlerSuite) Test_Moderation() {
+	delta, previous := float64(-400), float64(900)
+
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test remove player",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().RemovePlayer(gomock.Any(), &score.RemovePlayer{
+					Board:    "racing",
+					ClientID: "adam",
+					Reason:   "speed hack",
+				}).Return(nil).Times(1)
+
+				return h.mockHTTP.DELETE("/api/v1/admin/boards/racing/players/adam").
+					WithQuery("reason", "speed hack").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"status": "ok",
+			},
+		},
+		{
+			name: "test remove player without reason",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().RemovePlayer(gomock.Any(), gomock.Any()).Return(score.ErrInvalidReason).Times(1)
+
+				return h.mockHTTP.DELETE("/api/v1/admin/boards/racing/players/adam").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": score.ErrInvalidReason.Error(),
+			},
+		},
+		{
+			name: "test adjust score",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().SetScore(gomock.Any(), &score.SetScore{
+					Board:    "racing",
+					ClientID: "adam",
+					Delta:    &delta,
+					Reason:   "exploit refund",
+				}).Return(&model.ScoreResult{ClientID: "adam", Score: 500, Changed: true, Previous: &previous}, nil).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/admin/boards/racing/players/adam/score").
+					WithJSON(map[string]interface{}{"delta": -400, "reason": "exploit refund"}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId": "adam",
+				"score":    500,
+				"changed":  true,
+			},
+		},
+		{
+			name: "test ban client",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().Ban(gomock.Any(), &score.BanPlayer{
+					ClientID: "adam",
+					Reason:   "speed hack",
+				}).Return(&model.Ban{ClientID: "adam", Reason: "speed hack", Actor: "anonymous", CreatedAt: 1760000000}, nil).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/admin/bans/adam").
+					WithJSON(map[string]interface{}{"reason": "speed hack"}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId":  "adam",
+				"reason":    "speed hack",
+				"createdAt": 1760000000,
+			},
+		},
+		{
+			name: "test unban client not banned",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().Unban(gomock.Any(), &score.BanPlayer{
+					ClientID: "adam",
+					Reason:   "appeal accepted",
+				}).Return(model.ErrBanNotFound).Times(1)
+
+				return h.mockHTTP.DELETE("/api/v1/admin/bans/adam").
+					WithQuery("reason", "appeal accepted").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "ban not found",
+			},
+		},
+		{
+			name: "test get audit",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetAudit(gomock.Any(), &score.GetAudit{
+					ClientID: "adam",
+					From:     1760000000,
+					To:       1760003600,
+					Limit:    1,
+				}).Return(&model.AuditPage{
+					Events: []*model.AuditEvent{
+						{ID: "1760000500000-0", Action: model.AuditBan, Actor: "ops", ClientID: "adam", Reason: "speed hack", CreatedAt: 1760000500},
+					},
+					Next: "MTc2MDAwMDUwMDAwMC0w",
+				}, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/audit").
+					WithQuery("clientId", "adam").
+					WithQuery("from", 1760000000).
+					WithQuery("to", 1760003600).
+					WithQuery("limit", 1).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"events": []interface{}{
+					map[string]interface{}{
+						"id":        "1760000500000-0",
+						"action":    "ban",
+						"actor":     "ops",
+						"clientId":  "adam",
+						"reason":    "speed hack",
+						"createdAt": 1760000500,
+					},
+				},
+				"next": "MTc2MDAwMDUwMDAwMC0w",
+			},
+		},
+		{
+			name: "test get audit invalid range",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetAudit(gomock.Any(), gomock.Any()).Return(nil, score.ErrInvalidRange).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/admin/audit").
+					WithQuery("from", 1760003600).
+					WithQuery("to", 1760000000).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": score.ErrInvalidRange.Error(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// Origin - record the actor, the source IP and the request id in the context of request,
+// the request id is taken from X-Request-Id header or generated, and it is sent back in the response
+func Origin(c *C) {
+	id := c.GetHeader("X-Request-Id")
+	if !model.ValidID(id) {
+		id = newRequestID()
+	}
+	c.Header("X-Request-Id", id)
+
+	ctx := model.WithOrigin(c.Request().Context(), &model.Origin{
+		Actor:     c.Actor(),
+		IP:        c.RemoteAddr(),
+		RequestID: id,
+	})
+	c.ResetRequest(c.Request().WithContext(ctx))
+
+	c.Next()
+}
+
+// newRequestID - a random request id of 32 hex characters
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
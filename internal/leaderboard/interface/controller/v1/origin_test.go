@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/score"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_Origin
+func (h *handlerSuite) Test_Origin() {
+	tests := []struct {
+		name      string
+		requestID string
+		want      func(id string)
+	}{
+		{
+			name:      "test request id from header case",
+			requestID: "req-1",
+			want: func(id string) {
+				h.Equal("req-1", id)
+			},
+		},
+		{
+			name:      "test request id generated case",
+			requestID: "bad id!",
+			want: func(id string) {
+				h.Regexp(`^[0-9a-f]{32}$`, id)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			var got *model.Origin
+			h.mockScoreUsecase.EXPECT().Unban(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, command *score.BanPlayer) error {
+					got = model.OriginFrom(ctx)
+					return nil
+				}).Times(1)
+
+			id := h.mockHTTP.DELETE("/api/v1/admin/bans/adam").
+				WithQuery("reason", "appeal accepted").
+				WithHeader("X-Request-Id", test.requestID).
+				Expect().
+				Status(httptest.StatusOK).
+				Header("X-Request-Id").Raw()
+
+			test.want(id)
+			h.Require().NotNil(got)
+			h.Equal("anonymous", got.Actor)
+			h.Equal(id, got.RequestID)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/player"
+)
+
+// SaveProfile - the client can only save its own profile
+func (s *Server) SaveProfile(c *C) {
+	clientId := c.Params().Get("clientId")
+
+	// check clientId of head is the owner of profile
+	if !model.ValidID(clientId) || c.Request().Header.Get("ClientId") != clientId {
+		c.E(errors.New("bad request"))
+		return
+	}
+
+	// get body data
+	data := &player.SaveProfile{}
+	if err := c.ReadJSON(data); err != nil {
+		c.E(err)
+		return
+	}
+	data.ClientID = clientId
+
+	// usecase
+	profile, err := s.PlayerUsecase.SaveProfile(c.Request().Context(), data)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(profile)
+}
+
+// GetProfile -
+func (s *Server) GetProfile(c *C) {
+	profile, err := s.PlayerUsecase.GetProfile(c.Request().Context(), c.Params().Get("clientId"))
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(profile)
+}
+
+// SaveFriends - the client can only save its own friends
+func (s *Server) SaveFriends(c *C) {
+	clientId := c.Params().Get("clientId")
+
+	// check clientId of head is the owner of friends
+	if !model.ValidID(clientId) || c.Request().Header.Get("ClientId") != clientId {
+		c.E(errors.New("bad request"))
+		return
+	}
+
+	// get body data
+	data := &player.SaveFriends{}
+	if err := c.ReadJSON(data); err != nil {
+		c.E(err)
+		return
+	}
+	data.ClientID = clientId
+
+	// usecase
+	friends, err := s.PlayerUsecase.SaveFriends(c.Request().Context(), data)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(map[string]interface{}{
+		"friends": friends,
+	})
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/player"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_SaveProfile
+func (h *handlerSuite) Test_SaveProfile() {
+	type args struct {
+		headers map[string]string
+		body    interface{}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		fn   func(args) *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test save profile of other client",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "peter",
+				},
+				body: &player.SaveProfile{
+					DisplayName: "Adam",
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				return h.mockHTTP.PUT("/api/v1/players/adam").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "bad request",
+			},
+		},
+		{
+			name: "test save profile occur error",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: &player.SaveProfile{
+					DisplayName: "Adam",
+					Country:     "Taiwan",
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				command := in.body.(*player.SaveProfile)
+				command.ClientID = "adam"
+
+				h.mockPlayerUsecase.EXPECT().SaveProfile(gomock.Any(), command).Return(nil, player.ErrInvalidCountry).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/players/adam").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "invalid country",
+			},
+		},
+		{
+			name: "test save profile success",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: &player.SaveProfile{
+					DisplayName: "Adam",
+					AvatarURL:   "https://example.com/adam.png",
+					Country:     "TW",
+				},
+			},
+			fn: func(in args) *httpexpect.Object {
+				command := in.body.(*player.SaveProfile)
+				command.ClientID = "adam"
+
+				profile := &model.Profile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+					AvatarURL:   "https://example.com/adam.png",
+					Country:     "TW",
+				}
+				h.mockPlayerUsecase.EXPECT().SaveProfile(gomock.Any(), command).Return(profile, nil).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/players/adam").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId":    "adam",
+				"displayName": "Adam",
+				"avatarUrl":   "https://example.com/adam.png",
+				"country":     "TW",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn(test.args)
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetProfile
+func (h *handlerSuite) Test_GetProfile() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test get profile not found",
+			fn: func() *httpexpect.Object {
+				h.mockPlayerUsecase.EXPECT().GetProfile(gomock.Any(), "peter").Return(nil, model.ErrProfileNotFound).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/players/peter").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "profile not found",
+			},
+		},
+		{
+			name: "test get profile success",
+			fn: func() *httpexpect.Object {
+				profile := &model.Profile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+				}
+				h.mockPlayerUsecase.EXPECT().GetProfile(gomock.Any(), "adam").Return(profile, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/players/adam").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"clientId":    "adam",
+				"displayName": "Adam",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_SaveFriends
+func (h *handlerSuite) Test_SaveFriends() {
+	type args struct {
+		headers map[string]string
+		body    interface{}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		fn   func(args) *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test save friends of other client",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "peter",
+				},
+				body: map[string]interface{}{"friends": []string{"mary"}},
+			},
+			fn: func(in args) *httpexpect.Object {
+				return h.mockHTTP.PUT("/api/v1/players/adam/friends").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "bad request",
+			},
+		},
+		{
+			name: "test save friends occur error",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: map[string]interface{}{"friends": []string{"adam"}},
+			},
+			fn: func(in args) *httpexpect.Object {
+				h.mockPlayerUsecase.EXPECT().SaveFriends(gomock.Any(), &player.SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"adam"},
+				}).Return(nil, player.ErrInvalidFriend).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/players/adam/friends").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "invalid friend",
+			},
+		},
+		{
+			name: "test save friends success",
+			args: args{
+				headers: map[string]string{
+					"ClientId": "adam",
+				},
+				body: map[string]interface{}{"friends": []string{"peter", "mary"}},
+			},
+			fn: func(in args) *httpexpect.Object {
+				h.mockPlayerUsecase.EXPECT().SaveFriends(gomock.Any(), &player.SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"peter", "mary"},
+				}).Return([]string{"peter", "mary"}, nil).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/players/adam/friends").
+					WithHeaders(in.headers).
+					WithJSON(in.body).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"friends": []string{"peter", "mary"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn(test.args)
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
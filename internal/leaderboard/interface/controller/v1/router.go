@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/kataras/iris/v12/core/router"
 	"github.com/kataras/iris/v12/middleware/logger"
 	"github.com/kataras/iris/v12/middleware/recover"
 )
@@ -18,13 +19,114 @@ func (s *Server) SetRouter() {
 
 	r := s.App.Party("/api/v1")
 	{
-		// save score
-		r.Post("/score", HandleFunc(s.SaveScore))
+		// authenticate the requests before the signature of submission is checked
+		if s.AuthUsecase != nil {
+			r.Use(HandleFunc(s.Authorize))
+		}
 
-		// This endpoint can be created when the clientID is duplicated
-		r.Post("/dup/score", HandleFunc(s.SaveScoreIgnoreDuplicate))
+		// record the origin of request for the audit log
+		r.Use(HandleFunc(Origin))
 
-		// get LeaderBoard
-		r.Get("/leaderboard", HandleFunc(s.GetLeaderBoard))
+		// routes of the default board
+		s.setBoardRouter(r)
+
+		// routes of the specified board
+		s.setBoardRouter(r.Party("/boards/{board}"))
+
+		// save profile of client
+		r.Put("/players/{clientId}", HandleFunc(s.SaveProfile))
+
+		// get profile of client
+		r.Get("/players/{clientId}", HandleFunc(s.GetProfile))
+
+		// replace friends of client
+		r.Put("/players/{clientId}/friends", HandleFunc(s.SaveFriends))
+
+		// get team with its members
+		r.Get("/teams/{team}", HandleFunc(s.GetTeam))
+
+		admin := r.Party("/admin")
+		{
+			// create board
+			admin.Post("/boards", HandleFunc(s.CreateBoard))
+
+			// list boards
+			admin.Get("/boards", HandleFunc(s.ListBoards))
+
+			// delete board
+			admin.Delete("/boards/{board}", HandleFunc(s.DeleteBoard))
+
+			// reset board
+			admin.Post("/boards/{board}/reset", HandleFunc(s.ResetBoard))
+
+			// list the submissions rejected by the rules of board
+			admin.Get("/boards/{board}/quarantine", HandleFunc(s.GetQuarantine))
+
+			// remove player from board
+			admin.Delete("/boards/{board}/players/{clientId}", HandleFunc(s.RemovePlayer))
+
+			// set or adjust the score of player
+			admin.Put("/boards/{board}/players/{clientId}/score", HandleFunc(s.SetPlayerScore))
+
+			// ban client
+			admin.Put("/bans/{clientId}", HandleFunc(s.BanPlayer))
+
+			// lift the ban of client
+			admin.Delete("/bans/{clientId}", HandleFunc(s.UnbanPlayer))
+
+			// query the audit log by player and time range
+			admin.Get("/audit", HandleFunc(s.GetAudit))
+
+			// create team or rename it
+			admin.Put("/teams/{team}", HandleFunc(s.SaveTeam))
+
+			// move client to team
+			admin.Put("/teams/{team}/members/{clientId}", HandleFunc(s.JoinTeam))
+
+			// remove client from team
+			admin.Delete("/teams/{team}/members/{clientId}", HandleFunc(s.LeaveTeam))
+		}
 	}
 }
+
+// setBoardRouter set the routes which operate on one board
+func (s *Server) setBoardRouter(r router.Party) {
+	// save score
+	r.Post("/score", s.signed(s.SaveScore)...)
+
+	// save the scores of many clients
+	r.Post("/scores:batch", s.signed(s.SaveScores)...)
+
+	// save score as a new entry, the same clientID can have many entries
+	r.Post("/dup/score", s.signed(s.SaveScoreIgnoreDuplicate)...)
+
+	// get LeaderBoard
+	r.Get("/leaderboard", HandleFunc(s.GetLeaderBoard))
+
+	// get entries
+	r.Get("/leaderboard/entries", HandleFunc(s.GetEntries))
+
+	// list the archived seasons
+	r.Get("/leaderboard/seasons", HandleFunc(s.ListSeasons))
+
+	// get the archived standings of one season
+	r.Get("/leaderboard/seasons/{id:int64}", HandleFunc(s.GetSeason))
+
+	// get score and rank of one client
+	r.Get("/leaderboard/players/{clientId}", HandleFunc(s.GetPlayerRank))
+
+	// get the players around one client
+	r.Get("/leaderboard/around/{clientId}", HandleFunc(s.GetAroundPlayer))
+
+	// get the client and its friends ranked among them
+	r.Get("/leaderboard/friends/{clientId}", HandleFunc(s.GetFriends))
+
+	// get the team standings
+	r.Get("/leaderboard/teams", HandleFunc(s.GetTeamLeaderBoard))
+
+	// get score and rank of one team
+	r.Get("/leaderboard/teams/{team}", HandleFunc(s.GetTeamRank))
+
+	// get the teams around one team
+	r.Get("/leaderboard/teams/{team}/around", HandleFunc(s.GetAroundTeam))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"bytes"
+	"io"
+	"leaderboard/internal/leaderboard/usecase/signature"
+	"leaderboard/pkg/response"
+
+	"github.com/kataras/iris/v12/context"
+)
+
+const (
+	// CodeInvalidSignature - the error code of the submission failing the signature check
+	CodeInvalidSignature = "invalid_signature"
+)
+
+// VerifySignature - verify the HMAC signature of the submission from game server, the body is kept for the handler
+func (s *Server) VerifySignature(c *C) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		c.E(err)
+		return
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+	err = s.SignatureUsecase.Verify(c.Request().Context(), &signature.Verify{
+		Game:      c.GetHeader("X-Game-Id"),
+		ClientID:  c.GetHeader("ClientId"),
+		Timestamp: c.GetHeader("X-Timestamp"),
+		Nonce:     c.GetHeader("X-Nonce"),
+		Signature: c.GetHeader("X-Signature"),
+		Body:      body,
+	})
+	if err != nil {
+		c.E(response.WithCode(CodeInvalidSignature, err))
+		return
+	}
+
+	c.Next()
+}
+
+// signed - the handlers of the submission route, the signature is verified first when it is required
+func (s *Server) signed(handler func(*C)) []context.Handler {
+	if s.SignatureUsecase == nil {
+		return []context.Handler{HandleFunc(handler)}
+	}
+
+	return []context.Handler{HandleFunc(s.VerifySignature), HandleFunc(handler)}
+}
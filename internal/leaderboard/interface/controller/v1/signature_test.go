@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/signature"
+	socre "leaderboard/test/mock/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_VerifySignature
+func (h *handlerSuite) Test_VerifySignature() {
+	mockSignatureUsecase := socre.NewMockSignatureUsecase(h.ctrl)
+
+	server := &Server{
+		App:              iris.New(),
+		ScoreUsecase:     h.mockScoreUsecase,
+		SignatureUsecase: mockSignatureUsecase,
+	}
+	server.SetRouter()
+	e := httptest.New(h.T(), server.App, httptest.URL("http://localhost:8080"))
+
+	verify := &signature.Verify{
+		Game:      "racing",
+		ClientID:  "adam",
+		Timestamp: "1760000000",
+		Nonce:     "n-0001-abcd",
+		Signature: "9f86d081",
+		Body:      []byte(`{"score":100}`),
+	}
+	headers := map[string]string{
+		"ClientId":    "adam",
+		"X-Game-Id":   "racing",
+		"X-Timestamp": "1760000000",
+		"X-Nonce":     "n-0001-abcd",
+		"X-Signature": "9f86d081",
+	}
+
+	// the body read by the signature check is kept for the handler
+	mockSignatureUsecase.EXPECT().Verify(gomock.Any(), verify).Return(nil).Times(1)
+	h.mockScoreUsecase.EXPECT().Add(gomock.Any(), &score.AddScore{
+		Board:    model.DefaultBoard,
+		ClientID: "adam",
+		Score:    100,
+	}).Return(&model.ScoreResult{ClientID: "adam", Score: 100, Changed: true}, nil).Times(1)
+
+	e.POST("/api/v1/score").
+		WithHeaders(headers).
+		WithHeader("Content-Type", "application/json").
+		WithBytes(verify.Body).
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		ValueEqual("score", 100)
+
+	// the invalid signature is responded with its own code
+	mockSignatureUsecase.EXPECT().Verify(gomock.Any(), verify).Return(signature.ErrInvalidSignature).Times(1)
+
+	e.POST("/api/v1/score").
+		WithHeaders(headers).
+		WithHeader("Content-Type", "application/json").
+		WithBytes(verify.Body).
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		Value("status").Object().
+		ValueEqual("message", signature.ErrInvalidSignature.Error()).
+		ValueEqual("code", CodeInvalidSignature)
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/team"
+)
+
+// SaveTeam - create team or rename it
+func (s *Server) SaveTeam(c *C) {
+	// get body data
+	data := &team.SaveTeam{}
+	if err := c.ReadJSON(data); err != nil {
+		c.E(err)
+		return
+	}
+	data.ID = c.Params().Get("team")
+
+	// usecase
+	t, err := s.TeamUsecase.Save(c.Request().Context(), data)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(t)
+}
+
+// GetTeam -
+func (s *Server) GetTeam(c *C) {
+	t, err := s.TeamUsecase.Get(c.Request().Context(), c.Params().Get("team"))
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(t)
+}
+
+// JoinTeam - move client to team
+func (s *Server) JoinTeam(c *C) {
+	command := &team.Member{
+		Team:     c.Params().Get("team"),
+		ClientID: c.Params().Get("clientId"),
+	}
+
+	if err := s.TeamUsecase.Join(c.Request().Context(), command); err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(nil)
+}
+
+// LeaveTeam - remove client from team
+func (s *Server) LeaveTeam(c *C) {
+	command := &team.Member{
+		Team:     c.Params().Get("team"),
+		ClientID: c.Params().Get("clientId"),
+	}
+
+	if err := s.TeamUsecase.Leave(c.Request().Context(), command); err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(nil)
+}
+
+// GetTeamLeaderBoard - get one page of the team standings of board
+func (s *Server) GetTeamLeaderBoard(c *C) {
+	query := &score.GetLeaderBoard{
+		Board:  c.Board(),
+		Teams:  true,
+		Offset: c.URLParamInt64Default("offset", 0),
+		Limit:  c.URLParamInt64Default("limit", 0),
+		Next:   c.URLParam("next"),
+	}
+
+	page, err := s.ScoreUsecase.GetLeaderBoard(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(page)
+}
+
+// GetTeamRank - get score, rank and percentile of one team
+func (s *Server) GetTeamRank(c *C) {
+	query := &score.GetPlayer{
+		Board:    c.Board(),
+		Teams:    true,
+		ClientID: c.Params().Get("team"),
+	}
+
+	rank, err := s.ScoreUsecase.GetPlayerRank(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(rank)
+}
+
+// GetAroundTeam - get the teams ranked within radius above and below one team
+func (s *Server) GetAroundTeam(c *C) {
+	query := &score.GetPlayer{
+		Board:    c.Board(),
+		Teams:    true,
+		ClientID: c.Params().Get("team"),
+		Radius:   c.URLParamInt64Default("radius", 5),
+	}
+
+	scores, err := s.ScoreUsecase.GetAroundPlayer(c.Request().Context(), query)
+	if err != nil {
+		c.E(err)
+		return
+	}
+
+	c.R(map[string]interface{}{
+		"teams": scores,
+	})
+}
@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/usecase/score"
+	"leaderboard/internal/leaderboard/usecase/team"
+
+	"github.com/gavv/httpexpect"
+	"github.com/golang/mock/gomock"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// Test_SaveTeam
+func (h *handlerSuite) Test_SaveTeam() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test save team occur error",
+			fn: func() *httpexpect.Object {
+				h.mockTeamUsecase.EXPECT().Save(gomock.Any(), &team.SaveTeam{ID: "wolves"}).Return(nil, team.ErrInvalidName).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/admin/teams/wolves").
+					WithJSON(map[string]interface{}{}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "invalid team name",
+			},
+		},
+		{
+			name: "test save team success",
+			fn: func() *httpexpect.Object {
+				h.mockTeamUsecase.EXPECT().Save(gomock.Any(), &team.SaveTeam{ID: "wolves", Name: "Wolves"}).Return(&model.Team{
+					ID:      "wolves",
+					Name:    "Wolves",
+					Members: []string{"adam"},
+				}, nil).Times(1)
+
+				return h.mockHTTP.PUT("/api/v1/admin/teams/wolves").
+					WithJSON(map[string]interface{}{"name": "Wolves"}).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"id":      "wolves",
+				"name":    "Wolves",
+				"members": []string{"adam"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
+
+// Test_GetTeam
+func (h *handlerSuite) Test_GetTeam() {
+	h.mockTeamUsecase.EXPECT().Get(gomock.Any(), "bears").Return(nil, model.ErrTeamNotFound).Times(1)
+
+	h.mockHTTP.GET("/api/v1/teams/bears").
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		Value("status").Object().
+		ValueEqual("message", "team not found")
+}
+
+// Test_TeamMembers
+func (h *handlerSuite) Test_TeamMembers() {
+	h.mockTeamUsecase.EXPECT().Join(gomock.Any(), &team.Member{Team: "wolves", ClientID: "adam"}).Return(nil).Times(1)
+
+	h.mockHTTP.PUT("/api/v1/admin/teams/wolves/members/adam").
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		ValueEqual("status", "ok")
+
+	h.mockTeamUsecase.EXPECT().Join(gomock.Any(), &team.Member{Team: "wolves", ClientID: "peter"}).Return(model.ErrTeamFull).Times(1)
+
+	h.mockHTTP.PUT("/api/v1/admin/teams/wolves/members/peter").
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		Value("status").Object().
+		ValueEqual("message", "team is full")
+
+	h.mockTeamUsecase.EXPECT().Leave(gomock.Any(), &team.Member{Team: "wolves", ClientID: "adam"}).Return(nil).Times(1)
+
+	h.mockHTTP.DELETE("/api/v1/admin/teams/wolves/members/adam").
+		Expect().
+		Status(httptest.StatusOK).
+		JSON().Object().
+		ValueEqual("status", "ok")
+}
+
+// Test_TeamStandings
+func (h *handlerSuite) Test_TeamStandings() {
+	tests := []struct {
+		name string
+		fn   func() *httpexpect.Object
+		want map[string]interface{}
+	}{
+		{
+			name: "test GetTeamLeaderBoard success",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetLeaderBoard(gomock.Any(), &score.GetLeaderBoard{
+					Board: "clans",
+					Teams: true,
+					Limit: 5,
+				}).Return(&model.ScorePage{
+					Scores: []*model.Score{{ClientID: "wolves", Score: 300, Rank: 1}},
+					Total:  1,
+				}, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/clans/leaderboard/teams").
+					WithQuery("limit", 5).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"topPlayers": []*model.Score{{ClientID: "wolves", Score: 300, Rank: 1}},
+				"total":      1,
+			},
+		},
+		{
+			name: "test GetTeamRank occur error",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetPlayerRank(gomock.Any(), &score.GetPlayer{
+					Board:    model.DefaultBoard,
+					Teams:    true,
+					ClientID: "wolves",
+				}).Return(nil, score.ErrNoTeams).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/leaderboard/teams/wolves").
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object().
+					ContainsKey("status").
+					Value("status").Object()
+			},
+			want: map[string]interface{}{
+				"message": "board does not rank teams",
+			},
+		},
+		{
+			name: "test GetAroundTeam success",
+			fn: func() *httpexpect.Object {
+				h.mockScoreUsecase.EXPECT().GetAroundPlayer(gomock.Any(), &score.GetPlayer{
+					Board:    "clans",
+					Teams:    true,
+					ClientID: "bears",
+					Radius:   1,
+				}).Return([]*model.Score{
+					{ClientID: "wolves", Score: 300, Rank: 1},
+					{ClientID: "bears", Score: 200, Rank: 2},
+				}, nil).Times(1)
+
+				return h.mockHTTP.GET("/api/v1/boards/clans/leaderboard/teams/bears/around").
+					WithQuery("radius", 1).
+					Expect().
+					Status(httptest.StatusOK).
+					JSON().Object()
+			},
+			want: map[string]interface{}{
+				"teams": []*model.Score{
+					{ClientID: "wolves", Score: 300, Rank: 1},
+					{ClientID: "bears", Score: 200, Rank: 2},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+
+			expect := test.fn()
+			for k, w := range test.want {
+				expect.ValueEqual(k, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package auth
+
+// Credential
+type Credential struct {
+	// Token the JWT bearer token of player
+	Token string
+
+	// APIKey the static API key of server, it is used when there is no token
+	APIKey string
+}
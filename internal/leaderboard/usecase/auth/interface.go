@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// AuthUsecase -
+type AuthUsecase interface {
+	// Authenticate - verify the bearer token or the API key, and get the caller
+	Authenticate(ctx context.Context, command *Credential) (*model.Principal, error)
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// header - the JOSE header of token
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// claims - the registered claims of token and the scope
+type claims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt int64           `json:"exp"`
+	NotBefore int64           `json:"nbf"`
+
+	// Scope the scopes separated by space
+	Scope string `json:"scope"`
+}
+
+// audience - check the aud claim, it is a string or an array of strings
+func (c *claims) audience(aud string) bool {
+	var one string
+	if json.Unmarshal(c.Audience, &one) == nil {
+		return one == aud
+	}
+
+	var many []string
+	if json.Unmarshal(c.Audience, &many) == nil {
+		for _, a := range many {
+			if a == aud {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parse - verify the signature of token by its algorithm, and decode its claims,
+// only HS256 by secret and RS256 by the keys of JWKS are accepted
+func (u *usecase) parse(token string) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	h := &header{}
+	if err := decodeSegment(parts[0], h); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+
+	switch h.Alg {
+	case "HS256":
+		if u.secret == "" {
+			return nil, ErrInvalidToken
+		}
+
+		mac := hmac.New(sha256.New, []byte(u.secret))
+		mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, ErrInvalidToken
+		}
+
+	case "RS256":
+		key := u.key(h.Kid)
+		if key == nil {
+			return nil, ErrInvalidToken
+		}
+
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, ErrInvalidToken
+		}
+
+	default:
+		return nil, ErrInvalidToken
+	}
+
+	c := &claims{}
+	if err := decodeSegment(parts[1], c); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return c, nil
+}
+
+// key - the RSA key of kid, the only key is used when the token has no kid
+func (u *usecase) key(kid string) *rsa.PublicKey {
+	if kid == "" && len(u.keys) == 1 {
+		for _, k := range u.keys {
+			return k
+		}
+	}
+
+	return u.keys[kid]
+}
+
+// decodeSegment - decode the base64url JSON segment of token
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// loadJWKS - load the RSA signing keys of the JSON web key set file by kid
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}{}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, ErrInvalidJWKS
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrInvalidJWKS
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrInvalidJWKS
+	}
+
+	return keys, nil
+}
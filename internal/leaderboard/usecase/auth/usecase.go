@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnauthenticated -
+	ErrUnauthenticated = errors.New("missing credentials")
+
+	// ErrInvalidToken -
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrTokenExpired -
+	ErrTokenExpired = errors.New("token is expired")
+
+	// ErrInvalidAPIKey -
+	ErrInvalidAPIKey = errors.New("invalid api key")
+
+	// ErrInvalidJWKS -
+	ErrInvalidJWKS = errors.New("invalid jwks")
+)
+
+type usecase struct {
+	secret   string
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+	apiKeys  []config.APIKey
+}
+
+// NewUseCase - the keys of JWKS are loaded once
+func NewUseCase(conf config.Config) (AuthUsecase, error) {
+	u := &usecase{
+		secret:   conf.Auth.Secret,
+		issuer:   conf.Auth.Issuer,
+		audience: conf.Auth.Audience,
+		apiKeys:  conf.Auth.APIKeys,
+	}
+
+	if conf.Auth.Enabled && conf.Auth.JWKS != "" {
+		keys, err := loadJWKS(conf.Auth.JWKS)
+		if err != nil {
+			return nil, err
+		}
+		u.keys = keys
+	}
+
+	return u, nil
+}
+
+// Authenticate - the token is preferred, the API key is checked when there is no token
+func (u *usecase) Authenticate(ctx context.Context, command *Credential) (*model.Principal, error) {
+	if command.Token != "" {
+		return u.token(command.Token)
+	}
+
+	if command.APIKey != "" {
+		return u.apiKey(command.APIKey)
+	}
+
+	return nil, ErrUnauthenticated
+}
+
+// token - verify the token of player, it must have subject and expiry
+func (u *usecase) token(token string) (*model.Principal, error) {
+	c, err := u.parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	if c.Subject == "" || c.ExpiresAt == 0 || c.NotBefore > now {
+		return nil, ErrInvalidToken
+	}
+
+	if c.ExpiresAt <= now {
+		return nil, ErrTokenExpired
+	}
+
+	if (u.issuer != "" && c.Issuer != u.issuer) || (u.audience != "" && !c.audience(u.audience)) {
+		return nil, ErrInvalidToken
+	}
+
+	return &model.Principal{
+		Subject: c.Subject,
+		Scopes:  scopes(strings.Fields(c.Scope)),
+	}, nil
+}
+
+// apiKey - find the server of key, every key is compared in constant time
+func (u *usecase) apiKey(key string) (*model.Principal, error) {
+	var found *config.APIKey
+	for i := range u.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(u.apiKeys[i].Key), []byte(key)) == 1 {
+			found = &u.apiKeys[i]
+		}
+	}
+
+	if found == nil || found.Key == "" {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return &model.Principal{
+		Subject: found.Subject,
+		Scopes:  scopes(found.Scopes),
+		Server:  true,
+	}, nil
+}
+
+// scopes - the known scopes, the others are ignored
+func scopes(names []string) []model.Scope {
+	result := []model.Scope{}
+	for _, name := range names {
+		switch s := model.Scope(name); s {
+		case model.ScopeSubmit, model.ScopeRead, model.ScopeAdmin:
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
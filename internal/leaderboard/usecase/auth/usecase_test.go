@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	key     *rsa.PrivateKey
+	usecase AuthUsecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	t.Require().NoError(err)
+	t.key = key
+
+	jwks, _ := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "k1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	})
+	path := filepath.Join(t.T().TempDir(), "jwks.json")
+	t.Require().NoError(os.WriteFile(path, jwks, 0600))
+
+	t.usecase, err = NewUseCase(config.Config{
+		Auth: config.Auth{
+			Enabled:  true,
+			Secret:   "s3cret",
+			JWKS:     path,
+			Issuer:   "https://auth.example.com",
+			Audience: "leaderboard",
+			APIKeys: []config.APIKey{
+				{Key: "k-racing", Subject: "racing", Scopes: []string{"submit", "read", "unknown"}},
+			},
+		},
+	})
+	t.Require().NoError(err)
+}
+
+// TestAuthUsecase
+func TestAuthUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// sign - build the token signed by alg
+func (t *TestSuite) sign(alg, kid string, c map[string]interface{}) string {
+	h, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	b, _ := json.Marshal(c)
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(b)
+
+	var signature []byte
+	switch alg {
+	case "HS256":
+		mac := hmac.New(sha256.New, []byte("s3cret"))
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case "RS256":
+		digest := sha256.Sum256([]byte(signed))
+		signature, _ = rsa.SignPKCS1v15(rand.Reader, t.key, crypto.SHA256, digest[:])
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// Test_Authenticate
+func (t *TestSuite) Test_Authenticate() {
+	claims := func(modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub":   "adam",
+			"iss":   "https://auth.example.com",
+			"aud":   []string{"leaderboard", "shop"},
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": "submit read",
+		}
+		modify(c)
+		return c
+	}
+
+	player := &model.Principal{
+		Subject: "adam",
+		Scopes:  []model.Scope{model.ScopeSubmit, model.ScopeRead},
+	}
+
+	tests := []struct {
+		name          string
+		command       *Credential
+		wantPrincipal *model.Principal
+		wantError     error
+	}{
+		{
+			name:          "test HS256 token case",
+			command:       &Credential{Token: t.sign("HS256", "", claims(func(map[string]interface{}) {}))},
+			wantPrincipal: player,
+		},
+		{
+			name:          "test RS256 token case",
+			command:       &Credential{Token: t.sign("RS256", "k1", claims(func(map[string]interface{}) {}))},
+			wantPrincipal: player,
+		},
+		{
+			name: "test token with string audience case",
+			command: &Credential{Token: t.sign("HS256", "", claims(func(c map[string]interface{}) {
+				c["aud"] = "leaderboard"
+			}))},
+			wantPrincipal: player,
+		},
+		{
+			name: "test expired token case",
+			command: &Credential{Token: t.sign("RS256", "k1", claims(func(c map[string]interface{}) {
+				c["exp"] = time.Now().Add(-time.Minute).Unix()
+			}))},
+			wantError: ErrTokenExpired,
+		},
+		{
+			name: "test token without expiry case",
+			command: &Credential{Token: t.sign("HS256", "", claims(func(c map[string]interface{}) {
+				delete(c, "exp")
+			}))},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name: "test token of other audience case",
+			command: &Credential{Token: t.sign("HS256", "", claims(func(c map[string]interface{}) {
+				c["aud"] = "shop"
+			}))},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name: "test token of other issuer case",
+			command: &Credential{Token: t.sign("HS256", "", claims(func(c map[string]interface{}) {
+				c["iss"] = "https://evil.example.com"
+			}))},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name:      "test token of unknown kid case",
+			command:   &Credential{Token: t.sign("RS256", "k2", claims(func(map[string]interface{}) {}))},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name:      "test unsigned token case",
+			command:   &Credential{Token: t.sign("none", "", claims(func(map[string]interface{}) {}))},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name:      "test tampered token case",
+			command:   &Credential{Token: t.sign("HS256", "", claims(func(map[string]interface{}) {})) + "x"},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name:      "test malformed token case",
+			command:   &Credential{Token: "abc"},
+			wantError: ErrInvalidToken,
+		},
+		{
+			name:    "test api key case",
+			command: &Credential{APIKey: "k-racing"},
+			wantPrincipal: &model.Principal{
+				Subject: "racing",
+				Scopes:  []model.Scope{model.ScopeSubmit, model.ScopeRead},
+				Server:  true,
+			},
+		},
+		{
+			name:      "test invalid api key case",
+			command:   &Credential{APIKey: "k-boat"},
+			wantError: ErrInvalidAPIKey,
+		},
+		{
+			name:      "test missing credentials case",
+			command:   &Credential{},
+			wantError: ErrUnauthenticated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			p, err := t.usecase.Authenticate(context.Background(), test.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantPrincipal, p)
+		})
+	}
+}
+
+// Test_NewUseCase
+func (t *TestSuite) Test_NewUseCase() {
+	path := filepath.Join(t.T().TempDir(), "jwks.json")
+	t.Require().NoError(os.WriteFile(path, []byte(`{"keys":[]}`), 0600))
+
+	_, err := NewUseCase(config.Config{Auth: config.Auth{Enabled: true, JWKS: path}})
+	t.Equal(ErrInvalidJWKS, err)
+}
@@ -0,0 +1,45 @@
+package board
+
+import "leaderboard/internal/leaderboard/domain/model"
+
+// CreateBoard
+type CreateBoard struct {
+	// ID board identifier
+	ID string
+
+	// Name display name
+	Name string
+
+	// Order sort order (desc / asc)
+	Order model.Order
+
+	// Reset reset policy (ttl / cron / never)
+	Reset model.ResetPolicy
+
+	// Update update policy (latest / max / min / increment)
+	Update model.UpdatePolicy
+
+	// TieBreak tie-breaker of equal score (none / first / last)
+	TieBreak model.TieBreak
+
+	// RankMode rank numbering of equal score (ordinal / shared / dense)
+	RankMode model.RankMode
+
+	// Cron cron expression of reset, only for the board reset by cron
+	Cron string
+
+	// Timezone IANA timezone of cron expression, only for the board reset by cron
+	Timezone string
+
+	// TTL seconds to expire after the last score, only for the board reset by TTL
+	TTL int64
+
+	// Aggregate the definition of the board derived from other boards, optional
+	Aggregate *model.Aggregate
+
+	// Teams the scoring of the team standings, the teams are not ranked when it is nil
+	Teams *model.Teams
+
+	// Rules the anti-cheat rules of the submissions, optional
+	Rules *model.Rules
+}
@@ -0,0 +1,21 @@
+package board
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// BoardUsecase -
+type BoardUsecase interface {
+	// Init - make sure the default board exists
+	Init(ctx context.Context) error
+
+	// Create - create a board
+	Create(ctx context.Context, command *CreateBoard) (*model.Board, error)
+
+	// List - list all boards
+	List(ctx context.Context) ([]*model.Board, error)
+
+	// Delete - delete a board and its scores
+	Delete(ctx context.Context, id string) error
+}
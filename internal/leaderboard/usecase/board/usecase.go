@@ -0,0 +1,469 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/domain/repository"
+	"math"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxSources - the max number of the sources of aggregated board
+	MaxSources = 10
+
+	// DefaultAggregateTTL - the seconds the materialised aggregated board is cached when TTL is not set
+	DefaultAggregateTTL = 60
+)
+
+var (
+	// ErrInvalidBoardID -
+	ErrInvalidBoardID = errors.New("invalid board id")
+
+	// ErrInvalidOrder -
+	ErrInvalidOrder = errors.New("invalid order")
+
+	// ErrInvalidReset -
+	ErrInvalidReset = errors.New("invalid reset policy")
+
+	// ErrInvalidUpdate -
+	ErrInvalidUpdate = errors.New("invalid update policy")
+
+	// ErrInvalidTieBreak -
+	ErrInvalidTieBreak = errors.New("invalid tie-break")
+
+	// ErrInvalidRankMode -
+	ErrInvalidRankMode = errors.New("invalid rank mode")
+
+	// ErrInvalidCron -
+	ErrInvalidCron = errors.New("invalid cron expression")
+
+	// ErrInvalidTimezone -
+	ErrInvalidTimezone = errors.New("invalid timezone")
+
+	// ErrInvalidTTL -
+	ErrInvalidTTL = errors.New("invalid ttl")
+
+	// ErrInvalidAggregate -
+	ErrInvalidAggregate = errors.New("invalid aggregate")
+
+	// ErrInvalidSource -
+	ErrInvalidSource = errors.New("invalid aggregate source")
+
+	// ErrInvalidTeams -
+	ErrInvalidTeams = errors.New("invalid teams")
+
+	// ErrInvalidRules -
+	ErrInvalidRules = errors.New("invalid rules")
+
+	// ErrInvalidSegment -
+	ErrInvalidSegment = errors.New("invalid segment")
+
+	// ErrDeleteDefault -
+	ErrDeleteDefault = errors.New("default board can not be deleted")
+
+	// ErrBoardInUse -
+	ErrBoardInUse = errors.New("board is a source of aggregated board")
+)
+
+type usecase struct {
+	boardRepository repository.BoardRepository
+	defaults        config.Schedule
+	windows         config.Window
+
+	// location the location of the buckets of windows
+	location *time.Location
+
+	// segments the allowed segments, their sorted sets are deleted with the board
+	segments []model.Segment
+
+	auditRepository repository.AuditRepository
+
+	// auditMaxLen about how many latest events the audit log keeps
+	auditMaxLen int64
+
+	logger *zap.Logger
+}
+
+// NewUseCase -
+func NewUseCase(boardRepository repository.BoardRepository, auditRepository repository.AuditRepository, conf config.Config, logger *zap.Logger) (BoardUsecase, error) {
+	segments, ok := model.NewSegments(conf.Segment.Segments)
+	if !ok {
+		return nil, ErrInvalidSegment
+	}
+
+	location, err := time.LoadLocation(conf.Window.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase{
+		boardRepository: boardRepository,
+		defaults:        conf.Schedule,
+		windows:         conf.Window,
+		location:        location,
+		segments:        segments,
+		auditRepository: auditRepository,
+		auditMaxLen:     conf.Audit.MaxLen,
+		logger:          logger,
+	}, nil
+}
+
+// Init - create the default board when it does not exist,
+// and fill the schedule of the boards created before the schedule is configurable
+func (u *usecase) Init(ctx context.Context) error {
+	_, err := u.boardRepository.GetBoard(ctx, model.DefaultBoard)
+	if errors.Is(err, model.ErrBoardNotFound) {
+		_, err = u.Create(ctx, &CreateBoard{
+			ID:   model.DefaultBoard,
+			Name: "Leaderboard",
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	boards, err := u.boardRepository.ListBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range boards {
+		before := *b
+		if err := u.schedule(b); err != nil {
+			return err
+		}
+
+		if *b == before {
+			continue
+		}
+
+		if err := u.boardRepository.SaveBoard(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create - create one board, the empty fields use default value
+func (u *usecase) Create(ctx context.Context, command *CreateBoard) (*model.Board, error) {
+	if !model.ValidID(command.ID) {
+		return nil, ErrInvalidBoardID
+	}
+
+	board := &model.Board{
+		ID:        command.ID,
+		Name:      command.Name,
+		Order:     command.Order,
+		Reset:     command.Reset,
+		Update:    command.Update,
+		TieBreak:  command.TieBreak,
+		RankMode:  command.RankMode,
+		Cron:      command.Cron,
+		Timezone:  command.Timezone,
+		TTL:       command.TTL,
+		Aggregate: command.Aggregate,
+		Teams:     command.Teams,
+		Rules:     command.Rules,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	if board.Name == "" {
+		board.Name = board.ID
+	}
+
+	if board.Order == "" {
+		board.Order = model.OrderDesc
+	}
+
+	if !board.Order.Valid() {
+		return nil, ErrInvalidOrder
+	}
+
+	// the aggregated board is materialised from its sources, so it is never reset by default
+	if board.Reset == "" {
+		board.Reset = model.ResetTTL
+		if board.Aggregated() {
+			board.Reset = model.ResetNever
+		}
+	}
+
+	if !board.Reset.Valid() {
+		return nil, ErrInvalidReset
+	}
+
+	if err := u.schedule(board); err != nil {
+		return nil, err
+	}
+
+	if board.Update == "" {
+		board.Update = model.UpdateLatest
+	}
+
+	if !board.Update.Valid() {
+		return nil, ErrInvalidUpdate
+	}
+
+	if board.TieBreak == "" {
+		board.TieBreak = model.TieBreakNone
+	}
+
+	if !board.TieBreak.Valid() {
+		return nil, ErrInvalidTieBreak
+	}
+
+	// the accumulated score would leave the range encoded with time, so increment has no tie-break
+	if board.TieBreakEnabled() && board.Update == model.UpdateIncrement {
+		return nil, ErrInvalidTieBreak
+	}
+
+	if board.RankMode == "" {
+		board.RankMode = model.RankOrdinal
+	}
+
+	if !board.RankMode.Valid() {
+		return nil, ErrInvalidRankMode
+	}
+
+	if board.Aggregated() {
+		if err := u.aggregate(ctx, board); err != nil {
+			return nil, err
+		}
+	}
+
+	// the team standings are updated by the scores submitted, so the aggregated board has no team
+	if board.TeamsEnabled() && (board.Aggregated() || board.Teams.Top < 0) {
+		return nil, ErrInvalidTeams
+	}
+
+	// the scores can not be submitted to the aggregated board
+	if board.Rules != nil && (board.Aggregated() || !validRules(board.Rules)) {
+		return nil, ErrInvalidRules
+	}
+
+	// check if board exists
+	_, err := u.boardRepository.GetBoard(ctx, board.ID)
+	if err == nil {
+		return nil, model.ErrBoardExists
+	}
+	if !errors.Is(err, model.ErrBoardNotFound) {
+		return nil, err
+	}
+
+	if err := u.boardRepository.SaveBoard(ctx, board); err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+// List - list all boards
+func (u *usecase) List(ctx context.Context) ([]*model.Board, error) {
+	return u.boardRepository.ListBoards(ctx)
+}
+
+// Delete - delete board metadata and scores, the segments and the buckets of windows of board included.
+// The board combined by aggregated boards can not be deleted until they are deleted
+func (u *usecase) Delete(ctx context.Context, id string) error {
+	if id == model.DefaultBoard {
+		return ErrDeleteDefault
+	}
+
+	if _, err := u.boardRepository.GetBoard(ctx, id); err != nil {
+		return err
+	}
+
+	boards, err := u.boardRepository.ListBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range boards {
+		if !b.Aggregated() {
+			continue
+		}
+
+		for _, s := range b.Aggregate.Sources {
+			if s.Board == id {
+				return ErrBoardInUse
+			}
+		}
+	}
+
+	windows := make([]model.Window, len(u.windows.Windows))
+	for i, w := range u.windows.Windows {
+		windows[i] = model.Window(w)
+	}
+
+	if err := u.boardRepository.DeleteBoard(ctx, id, u.segments, windows, time.Now().In(u.location), u.windows.Keep); err != nil {
+		return err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action: model.AuditDelete,
+		Board:  id,
+	})
+
+	return nil
+}
+
+// audit - append the event to the audit log with the origin of request,
+// the board is deleted before, so a failed append is logged instead of failing the deletion
+func (u *usecase) audit(ctx context.Context, event *model.AuditEvent) {
+	event.Stamp(model.ActingOrigin(ctx), time.Now().Unix())
+
+	if err := u.auditRepository.AppendAudit(ctx, []*model.AuditEvent{event}, u.auditMaxLen); err != nil {
+		u.logger.Error("append audit failed", zap.Error(err), zap.Any("event", event))
+	}
+}
+
+// schedule - fill and validate the reset schedule by the reset policy of board,
+// the fields of other policies are cleared
+func (u *usecase) schedule(board *model.Board) error {
+	switch board.Reset {
+	case model.ResetCron:
+		board.TTL = 0
+
+		if board.Cron == "" {
+			board.Cron = u.defaults.Cron
+		}
+
+		if board.Timezone == "" {
+			board.Timezone = u.defaults.Timezone
+		}
+
+		if _, err := time.LoadLocation(board.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+
+		if _, err := cron.ParseStandard(board.CronSpec()); err != nil {
+			return ErrInvalidCron
+		}
+
+	case model.ResetTTL:
+		board.Cron, board.Timezone = "", ""
+
+		if board.TTL == 0 {
+			board.TTL = int64(u.defaults.TTL / time.Second)
+		}
+
+		if board.TTL <= 0 {
+			return ErrInvalidTTL
+		}
+
+	default:
+		board.Cron, board.Timezone, board.TTL = "", "", 0
+	}
+
+	return nil
+}
+
+// aggregate - fill and validate the definition of aggregated board, the sources must be the boards
+// recording scores without tie-break, and the windows of sources must be fanned out
+func (u *usecase) aggregate(ctx context.Context, board *model.Board) error {
+	a := board.Aggregate
+
+	// the scores are combined, so they can not be encoded with time or expire by TTL
+	if board.Reset != model.ResetNever || board.TieBreakEnabled() {
+		return ErrInvalidAggregate
+	}
+
+	if a.Op == "" {
+		a.Op = model.AggregateUnion
+	}
+
+	if a.Func == "" {
+		a.Func = model.AggregateSum
+	}
+
+	if a.TTL == 0 {
+		a.TTL = DefaultAggregateTTL
+	}
+
+	if !a.Op.Valid() || !a.Func.Valid() || a.TTL < 0 || len(a.Sources) == 0 || len(a.Sources) > MaxSources {
+		return ErrInvalidAggregate
+	}
+
+	if a.Refresh != "" {
+		if _, err := cron.ParseStandard(a.Refresh); err != nil {
+			return ErrInvalidCron
+		}
+	}
+
+	for _, s := range a.Sources {
+		if s == nil || s.Board == board.ID {
+			return ErrInvalidSource
+		}
+
+		source, err := u.boardRepository.GetBoard(ctx, s.Board)
+		if errors.Is(err, model.ErrBoardNotFound) {
+			return ErrInvalidSource
+		}
+		if err != nil {
+			return err
+		}
+
+		if source.Aggregated() || source.TieBreakEnabled() {
+			return ErrInvalidSource
+		}
+
+		if s.Weight == nil {
+			weight := float64(1)
+			s.Weight = &weight
+		}
+
+		if s.Window == "" || s.Window == model.WindowAllTime {
+			s.Window, s.Last = model.WindowAllTime, 0
+			continue
+		}
+
+		if !u.fannedOut(s.Window) {
+			return ErrInvalidSource
+		}
+
+		if s.Last == 0 {
+			s.Last = 1
+		}
+
+		// the buckets beyond the kept ones are expired
+		if s.Last < 0 || s.Last > u.windows.Keep {
+			return ErrInvalidSource
+		}
+	}
+
+	return nil
+}
+
+// validRules - the bounds are finite and ordered, and the limits are not negative
+func validRules(rules *model.Rules) bool {
+	finite := func(f float64) bool {
+		return !math.IsNaN(f) && !math.IsInf(f, 0)
+	}
+
+	if rules.Min != nil && !finite(*rules.Min) || rules.Max != nil && !finite(*rules.Max) {
+		return false
+	}
+
+	if rules.Min != nil && rules.Max != nil && *rules.Min > *rules.Max {
+		return false
+	}
+
+	return finite(rules.MaxDelta) && rules.MaxDelta >= 0 && rules.RatePerMinute >= 0
+}
+
+// fannedOut - check the scores are fanned out to window
+func (u *usecase) fannedOut(window model.Window) bool {
+	for _, w := range u.windows.Windows {
+		if model.Window(w) == window {
+			return true
+		}
+	}
+
+	return false
+}
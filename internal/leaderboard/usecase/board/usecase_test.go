@@ -0,0 +1,697 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/test/mock/repository"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockBoardRepository *repository.MockBoardRepository
+	mockAuditRepository *repository.MockAuditRepository
+	usecase             *usecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockBoardRepository = repository.NewMockBoardRepository(t.ctrl)
+	t.mockAuditRepository = repository.NewMockAuditRepository(t.ctrl)
+
+	t.usecase = &usecase{
+		boardRepository: t.mockBoardRepository,
+		auditRepository: t.mockAuditRepository,
+		auditMaxLen:     1000,
+		logger:          zap.NewNop(),
+		defaults: config.Schedule{
+			Cron:     "*/10 * * * *",
+			Timezone: "UTC",
+			TTL:      10 * time.Minute,
+		},
+		windows: config.Window{
+			Windows:  []string{"daily", "weekly"},
+			Timezone: "UTC",
+			Keep:     7,
+		},
+		location: time.UTC,
+		segments: []model.Segment{{Name: "country", Value: "TW"}},
+	}
+}
+
+// TestBoardUsecase
+func TestBoardUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// Test_Init
+func (t *TestSuite) Test_Init() {
+	tests := []struct {
+		name      string
+		fn        func()
+		wantError bool
+	}{
+		{
+			name: "test default board exists case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(&model.Board{ID: model.DefaultBoard}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{
+					{ID: model.DefaultBoard, Reset: model.ResetTTL, TTL: 600},
+					{ID: "racing", Reset: model.ResetCron, Cron: "0 0 * * *", Timezone: "Asia/Taipei"},
+				}, nil).Times(1)
+			},
+			wantError: false,
+		},
+		{
+			name: "test create default board case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(nil, model.ErrBoardNotFound).Times(2)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{
+					{ID: model.DefaultBoard, Reset: model.ResetTTL, TTL: 600},
+				}, nil).Times(1)
+			},
+			wantError: false,
+		},
+		{
+			name: "test fill schedule of old board case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(&model.Board{ID: model.DefaultBoard}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{
+					{ID: model.DefaultBoard, Reset: model.ResetTTL},
+				}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), &model.Board{
+					ID:    model.DefaultBoard,
+					Reset: model.ResetTTL,
+					TTL:   600,
+				}).Return(nil).Times(1)
+			},
+			wantError: false,
+		},
+		{
+			name: "test list boards error case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(&model.Board{ID: model.DefaultBoard}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return(nil, errors.New("")).Times(1)
+			},
+			wantError: true,
+		},
+		{
+			name: "test get default board error case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(nil, errors.New("")).Times(1)
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got := t.usecase.Init(context.Background())
+			t.Equal(test.wantError, got != nil)
+		})
+	}
+}
+
+// Test_Create
+func (t *TestSuite) Test_Create() {
+	minScore, maxScore := float64(0), float64(100000)
+	zero, one, two := float64(0), float64(1), float64(2)
+
+	type args struct {
+		ctx     context.Context
+		command *CreateBoard
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Board
+		wantError  error
+	}{
+		{
+			name: "test create board with default value case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "racing",
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "racing",
+				Name:     "racing",
+				Order:    model.OrderDesc,
+				Reset:    model.ResetTTL,
+				Update:   model.UpdateLatest,
+				TieBreak: model.TieBreakNone,
+				RankMode: model.RankOrdinal,
+				TTL:      600,
+			},
+		},
+		{
+			name: "test create board reset by cron case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					Reset:    model.ResetCron,
+					Cron:     "0 0 * * 1",
+					Timezone: "Asia/Taipei",
+					TTL:      60,
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "racing",
+				Name:     "racing",
+				Order:    model.OrderDesc,
+				Reset:    model.ResetCron,
+				Update:   model.UpdateLatest,
+				TieBreak: model.TieBreakNone,
+				RankMode: model.RankOrdinal,
+				Cron:     "0 0 * * 1",
+				Timezone: "Asia/Taipei",
+			},
+		},
+		{
+			name: "test create board case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					Name:     "Racing",
+					Order:    model.OrderAsc,
+					Reset:    model.ResetNever,
+					Update:   model.UpdateMin,
+					TieBreak: model.TieBreakFirst,
+					RankMode: model.RankDense,
+					TTL:      60,
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "racing",
+				Name:     "Racing",
+				Order:    model.OrderAsc,
+				Reset:    model.ResetNever,
+				Update:   model.UpdateMin,
+				TieBreak: model.TieBreakFirst,
+				RankMode: model.RankDense,
+			},
+		},
+		{
+			name: "test create aggregated board case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "racing").Return(&model.Board{ID: "racing"}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(&model.Board{ID: model.DefaultBoard}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "arena").Return(&model.Board{ID: "arena"}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Func: model.AggregateMax,
+						Sources: []*model.Source{
+							{Board: "racing", Weight: &two},
+							{Board: model.DefaultBoard, Window: model.WindowDaily, Last: 7},
+							{Board: "arena", Weight: &zero},
+						},
+						Refresh: "*/5 * * * *",
+					},
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "week",
+				Name:     "week",
+				Order:    model.OrderDesc,
+				Reset:    model.ResetNever,
+				Update:   model.UpdateLatest,
+				TieBreak: model.TieBreakNone,
+				RankMode: model.RankOrdinal,
+				Aggregate: &model.Aggregate{
+					Op:   model.AggregateUnion,
+					Func: model.AggregateMax,
+					Sources: []*model.Source{
+						{Board: "racing", Window: model.WindowAllTime, Weight: &two},
+						{Board: model.DefaultBoard, Window: model.WindowDaily, Last: 7, Weight: &one},
+						{Board: "arena", Window: model.WindowAllTime, Weight: &zero},
+					},
+					TTL:     DefaultAggregateTTL,
+					Refresh: "*/5 * * * *",
+				},
+			},
+		},
+		{
+			name: "test aggregated board reset by TTL case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "week",
+					Reset: model.ResetTTL,
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "racing"}},
+					},
+				},
+			},
+			wantError: ErrInvalidAggregate,
+		},
+		{
+			name: "test aggregated board without source case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:        "week",
+					Aggregate: &model.Aggregate{},
+				},
+			},
+			wantError: ErrInvalidAggregate,
+		},
+		{
+			name: "test aggregate source not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "unknown"}},
+					},
+				},
+			},
+			wantError: ErrInvalidSource,
+		},
+		{
+			name: "test aggregate source with tie-break case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "arena").Return(&model.Board{ID: "arena", TieBreak: model.TieBreakFirst}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "arena"}},
+					},
+				},
+			},
+			wantError: ErrInvalidSource,
+		},
+		{
+			name: "test aggregate window not fanned out case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "racing").Return(&model.Board{ID: "racing"}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "racing", Window: model.WindowMonthly}},
+					},
+				},
+			},
+			wantError: ErrInvalidSource,
+		},
+		{
+			name: "test aggregate buckets beyond kept case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "racing").Return(&model.Board{ID: "racing"}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "racing", Window: model.WindowDaily, Last: 8}},
+					},
+				},
+			},
+			wantError: ErrInvalidSource,
+		},
+		{
+			name: "test create board ranking teams case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "clans",
+					Reset: model.ResetNever,
+					Teams: &model.Teams{Top: 5},
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "clans",
+				Name:     "clans",
+				Order:    model.OrderDesc,
+				Reset:    model.ResetNever,
+				Update:   model.UpdateLatest,
+				TieBreak: model.TieBreakNone,
+				RankMode: model.RankOrdinal,
+				Teams:    &model.Teams{Top: 5},
+			},
+		},
+		{
+			name: "test invalid teams case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "clans",
+					Teams: &model.Teams{Top: -1},
+				},
+			},
+			wantError: ErrInvalidTeams,
+		},
+		{
+			name: "test create board with rules case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(nil, model.ErrBoardNotFound).Times(1)
+				t.mockBoardRepository.EXPECT().SaveBoard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "ranked",
+					Reset: model.ResetNever,
+					Rules: &model.Rules{Min: &minScore, Max: &maxScore, MaxDelta: 500, RatePerMinute: 10, Monotonic: true},
+				},
+			},
+			wantResult: &model.Board{
+				ID:       "ranked",
+				Name:     "ranked",
+				Order:    model.OrderDesc,
+				Reset:    model.ResetNever,
+				Update:   model.UpdateLatest,
+				TieBreak: model.TieBreakNone,
+				RankMode: model.RankOrdinal,
+				Rules:    &model.Rules{Min: &minScore, Max: &maxScore, MaxDelta: 500, RatePerMinute: 10, Monotonic: true},
+			},
+		},
+		{
+			name: "test rules with min above max case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "ranked",
+					Rules: &model.Rules{Min: &maxScore, Max: &minScore},
+				},
+			},
+			wantError: ErrInvalidRules,
+		},
+		{
+			name: "test rules with negative rate case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "ranked",
+					Rules: &model.Rules{RatePerMinute: -1},
+				},
+			},
+			wantError: ErrInvalidRules,
+		},
+		{
+			name: "test aggregated board ranking teams case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "racing").Return(&model.Board{ID: "racing"}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "week",
+					Teams: &model.Teams{},
+					Aggregate: &model.Aggregate{
+						Sources: []*model.Source{{Board: "racing"}},
+					},
+				},
+			},
+			wantError: ErrInvalidTeams,
+		},
+		{
+			name: "test invalid board id case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "racing:*",
+				},
+			},
+			wantError: ErrInvalidBoardID,
+		},
+		{
+			name: "test invalid order case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "racing",
+					Order: "random",
+				},
+			},
+			wantError: ErrInvalidOrder,
+		},
+		{
+			name: "test invalid reset policy case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "racing",
+					Reset: "sometimes",
+				},
+			},
+			wantError: ErrInvalidReset,
+		},
+		{
+			name: "test invalid cron case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:    "racing",
+					Reset: model.ResetCron,
+					Cron:  "every monday",
+				},
+			},
+			wantError: ErrInvalidCron,
+		},
+		{
+			name: "test invalid timezone case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					Reset:    model.ResetCron,
+					Timezone: "Mars/Olympus",
+				},
+			},
+			wantError: ErrInvalidTimezone,
+		},
+		{
+			name: "test invalid ttl case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:  "racing",
+					TTL: -1,
+				},
+			},
+			wantError: ErrInvalidTTL,
+		},
+		{
+			name: "test invalid update policy case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:     "racing",
+					Update: "sometimes",
+				},
+			},
+			wantError: ErrInvalidUpdate,
+		},
+		{
+			name: "test invalid tie-break case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					TieBreak: "random",
+				},
+			},
+			wantError: ErrInvalidTieBreak,
+		},
+		{
+			name: "test tie-break with increment case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					Update:   model.UpdateIncrement,
+					TieBreak: model.TieBreakFirst,
+				},
+			},
+			wantError: ErrInvalidTieBreak,
+		},
+		{
+			name: "test invalid rank mode case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID:       "racing",
+					RankMode: "random",
+				},
+			},
+			wantError: ErrInvalidRankMode,
+		},
+		{
+			name: "test board exists case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.command.ID).Return(&model.Board{ID: in.command.ID}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &CreateBoard{
+					ID: "racing",
+				},
+			},
+			wantError: model.ErrBoardExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.Create(test.args.ctx, test.args.command)
+			t.Equal(test.wantError, err)
+
+			if test.wantResult != nil {
+				test.wantResult.CreatedAt = got.CreatedAt
+			}
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_Delete
+func (t *TestSuite) Test_Delete() {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(args)
+		args      args
+		wantError error
+	}{
+		{
+			name: "test delete board case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.id).Return(&model.Board{ID: in.id}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{
+					{ID: in.id},
+					{ID: "modes", Aggregate: &model.Aggregate{Sources: []*model.Source{{Board: "arena"}}}},
+				}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().DeleteBoard(gomock.Any(), in.id, t.usecase.segments, []model.Window{model.WindowDaily, model.WindowWeekly}, gomock.Any(), 7).Return(nil).Times(1)
+				t.mockAuditRepository.EXPECT().AppendAudit(gomock.Any(), gomock.Any(), int64(1000)).
+					DoAndReturn(func(ctx context.Context, events []*model.AuditEvent, max int64) error {
+						t.Len(events, 1)
+						t.Equal(model.AuditDelete, events[0].Action)
+						t.Equal("ops", events[0].Actor)
+						t.Equal(in.id, events[0].Board)
+						return nil
+					}).Times(1)
+			},
+			args: args{
+				ctx: model.WithOrigin(context.Background(), &model.Origin{Actor: "ops"}),
+				id:  "racing",
+			},
+		},
+		{
+			name: "test delete source of aggregated board case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.id).Return(&model.Board{ID: in.id}, nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{
+					{ID: in.id},
+					{ID: "modes", Aggregate: &model.Aggregate{Sources: []*model.Source{{Board: "arena"}, {Board: in.id}}}},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: ErrBoardInUse,
+		},
+		{
+			name: "test delete board not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), in.id).Return(nil, model.ErrBoardNotFound).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "racing",
+			},
+			wantError: model.ErrBoardNotFound,
+		},
+		{
+			name: "test delete default board case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				id:  model.DefaultBoard,
+			},
+			wantError: ErrDeleteDefault,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got := t.usecase.Delete(test.args.ctx, test.args.id)
+			t.Equal(test.wantError, got)
+		})
+	}
+}
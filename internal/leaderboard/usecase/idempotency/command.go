@@ -0,0 +1,13 @@
+package idempotency
+
+// Request
+type Request struct {
+	// Key the Idempotency-Key of request
+	Key string
+
+	// Scope the key is unique within scope, e.g. the client of request
+	Scope string
+
+	// Payload the route and body of request, the key can not be reused with another payload
+	Payload []byte
+}
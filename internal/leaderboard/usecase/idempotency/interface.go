@@ -0,0 +1,21 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// IdempotencyUsecase -
+type IdempotencyUsecase interface {
+	// Begin - reserve the key of request, the result is returned when the request has completed
+	Begin(ctx context.Context, command *Request) (json.RawMessage, error)
+
+	// Complete - record the result of request for the repeated requests
+	Complete(ctx context.Context, command *Request, result interface{}) error
+
+	// Fail - record the error of request failed after it wrote, so it is not retried
+	Fail(ctx context.Context, command *Request, err error) error
+
+	// Abort - release the key of request, so the failed request can be retried
+	Abort(ctx context.Context, command *Request) error
+}
@@ -0,0 +1,111 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/domain/repository"
+	"time"
+)
+
+var (
+	// ErrInvalidKey -
+	ErrInvalidKey = errors.New("invalid idempotency key")
+
+	// ErrInProgress -
+	ErrInProgress = errors.New("request with the same idempotency key is in progress")
+
+	// ErrKeyReused -
+	ErrKeyReused = errors.New("idempotency key is used by another request")
+)
+
+type usecase struct {
+	idempotencyRepository repository.IdempotencyRepository
+	ttl                   time.Duration
+
+	// lease how long the key is reserved before the request completes
+	lease time.Duration
+}
+
+// NewUseCase -
+func NewUseCase(idempotencyRepository repository.IdempotencyRepository, conf config.Config) IdempotencyUsecase {
+	return &usecase{
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   conf.Idempotency.TTL,
+		lease:                 conf.Idempotency.Lease,
+	}
+}
+
+// Begin - reserve the key for the lease, the repeated request gets the result or the error of the completed one,
+// and it is rejected while the first one is in progress
+func (u *usecase) Begin(ctx context.Context, command *Request) (json.RawMessage, error) {
+	if !model.ValidID(command.Key) {
+		return nil, ErrInvalidKey
+	}
+
+	fingerprint := fingerprint(command.Payload)
+
+	recorded, err := u.idempotencyRepository.Reserve(ctx, key(command), &model.IdempotentRequest{
+		Fingerprint: fingerprint,
+	}, u.lease)
+	if err != nil || recorded == nil {
+		return nil, err
+	}
+
+	if recorded.Fingerprint != fingerprint {
+		return nil, ErrKeyReused
+	}
+
+	if !recorded.Done {
+		return nil, ErrInProgress
+	}
+
+	if recorded.Error != "" {
+		return nil, errors.New(recorded.Error)
+	}
+
+	return recorded.Result, nil
+}
+
+// Complete - record the result, the key is kept for the TTL since the request completed
+func (u *usecase) Complete(ctx context.Context, command *Request, result interface{}) error {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	return u.idempotencyRepository.Complete(ctx, key(command), &model.IdempotentRequest{
+		Fingerprint: fingerprint(command.Payload),
+		Done:        true,
+		Result:      b,
+	}, u.ttl)
+}
+
+// Fail - record the error, the key is kept for the TTL since the request failed
+func (u *usecase) Fail(ctx context.Context, command *Request, err error) error {
+	return u.idempotencyRepository.Complete(ctx, key(command), &model.IdempotentRequest{
+		Fingerprint: fingerprint(command.Payload),
+		Done:        true,
+		Error:       err.Error(),
+	}, u.ttl)
+}
+
+// Abort - release the key
+func (u *usecase) Abort(ctx context.Context, command *Request) error {
+	return u.idempotencyRepository.Release(ctx, key(command))
+}
+
+// key - the key scoped, the same key of different scopes are different requests
+func key(command *Request) string {
+	return command.Scope + ":" + command.Key
+}
+
+// fingerprint - the hex sha256 of payload
+func fingerprint(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
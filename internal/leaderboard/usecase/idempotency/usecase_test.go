@@ -0,0 +1,150 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/test/mock/repository"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	ctrl                      *gomock.Controller
+	mockIdempotencyRepository *repository.MockIdempotencyRepository
+	usecase                   *usecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockIdempotencyRepository = repository.NewMockIdempotencyRepository(t.ctrl)
+
+	t.usecase = &usecase{
+		idempotencyRepository: t.mockIdempotencyRepository,
+		ttl:                   time.Hour,
+		lease:                 time.Minute,
+	}
+}
+
+// TestIdempotencyUsecase
+func TestIdempotencyUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// Test_Begin
+func (t *TestSuite) Test_Begin() {
+	command := &Request{Key: "k1", Scope: "adam", Payload: []byte(`/api/v1/score`)}
+	pending := &model.IdempotentRequest{Fingerprint: fingerprint(command.Payload)}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		command    *Request
+		wantResult json.RawMessage
+		wantError  error
+	}{
+		{
+			name: "test first request case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(nil, nil).Times(1)
+			},
+			command: command,
+		},
+		{
+			name: "test repeated request case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(&model.IdempotentRequest{
+					Fingerprint: pending.Fingerprint,
+					Done:        true,
+					Result:      json.RawMessage(`{"score":10}`),
+				}, nil).Times(1)
+			},
+			command:    command,
+			wantResult: json.RawMessage(`{"score":10}`),
+		},
+		{
+			name: "test repeated failed request case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(&model.IdempotentRequest{
+					Fingerprint: pending.Fingerprint,
+					Done:        true,
+					Error:       "failed",
+				}, nil).Times(1)
+			},
+			command:   command,
+			wantError: errors.New("failed"),
+		},
+		{
+			name: "test request in progress case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(pending, nil).Times(1)
+			},
+			command:   command,
+			wantError: ErrInProgress,
+		},
+		{
+			name: "test key reused by another request case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(&model.IdempotentRequest{
+					Fingerprint: "other",
+					Done:        true,
+				}, nil).Times(1)
+			},
+			command:   command,
+			wantError: ErrKeyReused,
+		},
+		{
+			name: "test reserve error case",
+			fn: func() {
+				t.mockIdempotencyRepository.EXPECT().Reserve(gomock.Any(), "adam:k1", pending, time.Minute).Return(nil, errors.New("")).Times(1)
+			},
+			command:   command,
+			wantError: errors.New(""),
+		},
+		{
+			name:      "test invalid key case",
+			fn:        func() {},
+			command:   &Request{Key: "k:1", Scope: "adam"},
+			wantError: ErrInvalidKey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.Begin(context.Background(), test.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_Complete
+func (t *TestSuite) Test_Complete() {
+	command := &Request{Key: "k1", Scope: "adam", Payload: []byte(`/api/v1/score`)}
+
+	t.mockIdempotencyRepository.EXPECT().Complete(gomock.Any(), "adam:k1", &model.IdempotentRequest{
+		Fingerprint: fingerprint(command.Payload),
+		Done:        true,
+		Result:      json.RawMessage(`{"clientId":"adam","score":10,"changed":true}`),
+	}, time.Hour).Return(nil).Times(1)
+	t.NoError(t.usecase.Complete(context.Background(), command, &model.ScoreResult{ClientID: "adam", Score: 10, Changed: true}))
+
+	t.mockIdempotencyRepository.EXPECT().Complete(gomock.Any(), "adam:k1", &model.IdempotentRequest{
+		Fingerprint: fingerprint(command.Payload),
+		Done:        true,
+		Error:       "failed",
+	}, time.Hour).Return(nil).Times(1)
+	t.NoError(t.usecase.Fail(context.Background(), command, errors.New("failed")))
+
+	t.mockIdempotencyRepository.EXPECT().Release(gomock.Any(), "adam:k1").Return(nil).Times(1)
+	t.NoError(t.usecase.Abort(context.Background(), command))
+}
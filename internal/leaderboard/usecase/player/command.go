@@ -0,0 +1,25 @@
+package player
+
+// SaveProfile
+type SaveProfile struct {
+	// ClientID client id
+	ClientID string `json:"-"`
+
+	// DisplayName the name shown on leaderboard
+	DisplayName string
+
+	// AvatarURL http(s) url of the avatar image, optional
+	AvatarURL string
+
+	// Country ISO 3166-1 alpha-2 country code, optional
+	Country string
+}
+
+// SaveFriends
+type SaveFriends struct {
+	// ClientID client id
+	ClientID string `json:"-"`
+
+	// Friends the client ids of friends, it replaces the previous friends
+	Friends []string
+}
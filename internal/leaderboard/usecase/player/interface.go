@@ -0,0 +1,18 @@
+package player
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// PlayerUsecase -
+type PlayerUsecase interface {
+	// SaveProfile - create or replace the profile of player
+	SaveProfile(ctx context.Context, command *SaveProfile) (*model.Profile, error)
+
+	// GetProfile - get the profile of player
+	GetProfile(ctx context.Context, clientID string) (*model.Profile, error)
+
+	// SaveFriends - replace the friends of player
+	SaveFriends(ctx context.Context, command *SaveFriends) ([]string, error)
+}
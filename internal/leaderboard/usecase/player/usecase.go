@@ -0,0 +1,140 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/domain/repository"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxDisplayName - the max characters of display name
+	MaxDisplayName = 32
+
+	// MaxAvatarURL - the max length of avatar url
+	MaxAvatarURL = 512
+
+	// MaxFriends - the max number of friends of one player
+	MaxFriends = 500
+)
+
+var (
+	// country - ISO 3166-1 alpha-2 code
+	country = regexp.MustCompile(`^[A-Z]{2}$`)
+
+	// ErrInvalidDisplayName -
+	ErrInvalidDisplayName = errors.New("invalid display name")
+
+	// ErrInvalidAvatarURL -
+	ErrInvalidAvatarURL = errors.New("invalid avatar url")
+
+	// ErrInvalidCountry -
+	ErrInvalidCountry = errors.New("invalid country")
+
+	// ErrInvalidClientID -
+	ErrInvalidClientID = errors.New("invalid client id")
+
+	// ErrInvalidFriend -
+	ErrInvalidFriend = errors.New("invalid friend")
+
+	// ErrTooManyFriends -
+	ErrTooManyFriends = errors.New("too many friends")
+)
+
+type usecase struct {
+	playerRepository repository.PlayerRepository
+}
+
+// NewUseCase -
+func NewUseCase(playerRepository repository.PlayerRepository) PlayerUsecase {
+	return &usecase{
+		playerRepository: playerRepository,
+	}
+}
+
+// SaveProfile - validate and save the profile of player
+func (u *usecase) SaveProfile(ctx context.Context, command *SaveProfile) (*model.Profile, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	profile := &model.Profile{
+		ClientID:    command.ClientID,
+		DisplayName: strings.TrimSpace(command.DisplayName),
+		AvatarURL:   command.AvatarURL,
+		Country:     strings.ToUpper(command.Country),
+	}
+
+	if profile.DisplayName == "" || utf8.RuneCountInString(profile.DisplayName) > MaxDisplayName {
+		return nil, ErrInvalidDisplayName
+	}
+
+	if profile.AvatarURL != "" && !validAvatarURL(profile.AvatarURL) {
+		return nil, ErrInvalidAvatarURL
+	}
+
+	if profile.Country != "" && !country.MatchString(profile.Country) {
+		return nil, ErrInvalidCountry
+	}
+
+	if err := u.playerRepository.SaveProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// GetProfile - get the profile of player
+func (u *usecase) GetProfile(ctx context.Context, clientID string) (*model.Profile, error) {
+	return u.playerRepository.GetProfile(ctx, clientID)
+}
+
+// SaveFriends - validate and replace the friends of player, the duplicate friends are removed
+func (u *usecase) SaveFriends(ctx context.Context, command *SaveFriends) ([]string, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	friends := make([]string, 0, len(command.Friends))
+	seen := make(map[string]bool, len(command.Friends))
+
+	for _, f := range command.Friends {
+		if !model.ValidID(f) || f == command.ClientID {
+			return nil, ErrInvalidFriend
+		}
+
+		if seen[f] {
+			continue
+		}
+		seen[f] = true
+		friends = append(friends, f)
+	}
+
+	if len(friends) > MaxFriends {
+		return nil, ErrTooManyFriends
+	}
+
+	if err := u.playerRepository.SaveFriends(ctx, command.ClientID, friends); err != nil {
+		return nil, err
+	}
+
+	return friends, nil
+}
+
+// validAvatarURL - the avatar is shown by clients, so only absolute http(s) url is allowed
+func validAvatarURL(s string) bool {
+	if len(s) > MaxAvatarURL {
+		return false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,292 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/test/mock/repository"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	ctrl                 *gomock.Controller
+	mockPlayerRepository *repository.MockPlayerRepository
+	usecase              *usecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockPlayerRepository = repository.NewMockPlayerRepository(t.ctrl)
+
+	t.usecase = &usecase{
+		playerRepository: t.mockPlayerRepository,
+	}
+}
+
+// TestPlayerUsecase
+func TestPlayerUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// Test_SaveProfile
+func (t *TestSuite) Test_SaveProfile() {
+	type args struct {
+		ctx     context.Context
+		command *SaveProfile
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Profile
+		wantError  error
+	}{
+		{
+			name: "test save profile case",
+			fn: func(in args) {
+				t.mockPlayerRepository.EXPECT().SaveProfile(gomock.Any(), &model.Profile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+					AvatarURL:   "https://example.com/adam.png",
+					Country:     "TW",
+				}).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: " Adam ",
+					AvatarURL:   "https://example.com/adam.png",
+					Country:     "tw",
+				},
+			},
+			wantResult: &model.Profile{
+				ClientID:    "adam",
+				DisplayName: "Adam",
+				AvatarURL:   "https://example.com/adam.png",
+				Country:     "TW",
+			},
+		},
+		{
+			name: "test empty display name case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: "  ",
+				},
+			},
+			wantError: ErrInvalidDisplayName,
+		},
+		{
+			name: "test too long display name case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: strings.Repeat("名", MaxDisplayName+1),
+				},
+			},
+			wantError: ErrInvalidDisplayName,
+		},
+		{
+			name: "test invalid avatar url case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+					AvatarURL:   "javascript:alert(1)",
+				},
+			},
+			wantError: ErrInvalidAvatarURL,
+		},
+		{
+			name: "test invalid country case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+					Country:     "Taiwan",
+				},
+			},
+			wantError: ErrInvalidCountry,
+		},
+		{
+			name: "test save profile error case",
+			fn: func(in args) {
+				t.mockPlayerRepository.EXPECT().SaveProfile(gomock.Any(), gomock.Any()).Return(errors.New("error")).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveProfile{
+					ClientID:    "adam",
+					DisplayName: "Adam",
+				},
+			},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.SaveProfile(test.args.ctx, test.args.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_SaveFriends
+func (t *TestSuite) Test_SaveFriends() {
+	type args struct {
+		ctx     context.Context
+		command *SaveFriends
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult []string
+		wantError  error
+	}{
+		{
+			name: "test save friends case",
+			fn: func(in args) {
+				t.mockPlayerRepository.EXPECT().SaveFriends(gomock.Any(), "adam", []string{"peter", "mary"}).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"peter", "mary", "peter"},
+				},
+			},
+			wantResult: []string{"peter", "mary"},
+		},
+		{
+			name: "test clear friends case",
+			fn: func(in args) {
+				t.mockPlayerRepository.EXPECT().SaveFriends(gomock.Any(), "adam", []string{}).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+				},
+			},
+			wantResult: []string{},
+		},
+		{
+			name: "test befriend self case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"peter", "adam"},
+				},
+			},
+			wantError: ErrInvalidFriend,
+		},
+		{
+			name: "test friend with unsafe id case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"peter:profile"},
+				},
+			},
+			wantError: ErrInvalidFriend,
+		},
+		{
+			name: "test invalid client id case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam:friends",
+					Friends:  []string{"peter"},
+				},
+			},
+			wantError: ErrInvalidClientID,
+		},
+		{
+			name: "test empty friend case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{""},
+				},
+			},
+			wantError: ErrInvalidFriend,
+		},
+		{
+			name: "test too many friends case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  friends(MaxFriends + 1),
+				},
+			},
+			wantError: ErrTooManyFriends,
+		},
+		{
+			name: "test save friends error case",
+			fn: func(in args) {
+				t.mockPlayerRepository.EXPECT().SaveFriends(gomock.Any(), "adam", []string{"peter"}).Return(errors.New("error")).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &SaveFriends{
+					ClientID: "adam",
+					Friends:  []string{"peter"},
+				},
+			},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.SaveFriends(test.args.ctx, test.args.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// friends - the distinct client ids of n friends
+func friends(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = "friend-" + strconv.Itoa(i)
+	}
+
+	return ids
+}
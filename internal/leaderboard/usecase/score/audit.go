@@ -0,0 +1,101 @@
+package score
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrInvalidRange -
+	ErrInvalidRange = errors.New("invalid time range")
+
+	// eventIDRegex - the id of event in the audit log
+	eventIDRegex = regexp.MustCompile(`^[0-9]+-[0-9]+$`)
+)
+
+// GetAudit - query the audit log by player and time range, the latest first.
+// The events of player are read by the index of player, and all the events are read when no player is queried
+func (u *usecase) GetAudit(ctx context.Context, query *GetAudit) (*model.AuditPage, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	if limit < 0 || limit > MaxPageSize {
+		return nil, ErrInvalidPage
+	}
+
+	to := time.Now()
+	if query.To != 0 {
+		to = time.Unix(query.To, int64(time.Second-time.Millisecond))
+	}
+	from := time.Unix(query.From, 0)
+
+	if query.From < 0 || from.After(to) {
+		return nil, ErrInvalidRange
+	}
+
+	before, err := decodeEventCursor(query.Next)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*model.AuditEvent
+	if query.ClientID != "" {
+		events, err = u.auditRepository.ListPlayerAudit(ctx, query.ClientID, from, to, before, limit)
+	} else {
+		events, err = u.auditRepository.ListAudit(ctx, from, to, before, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.AuditPage{Events: events}
+
+	// the page is full, so the next page continues after its last event
+	if int64(len(events)) == limit {
+		result.Next = encodeEventCursor(events[len(events)-1].ID)
+	}
+
+	return result, nil
+}
+
+// audit - append the events to the audit log with the origin of request. The change is made before,
+// so a failed append is logged instead of failing the change, otherwise the retry would make it twice
+func (u *usecase) audit(ctx context.Context, events ...*model.AuditEvent) {
+	origin := model.ActingOrigin(ctx)
+	now := time.Now().Unix()
+
+	for _, e := range events {
+		e.Stamp(origin, now)
+	}
+
+	if err := u.auditRepository.AppendAudit(ctx, events, u.auditMaxLen); err != nil {
+		u.logger.Error("append audit failed", zap.Error(err), zap.Any("events", events))
+	}
+}
+
+// encodeEventCursor - encode the id of the last event of page to an opaque token
+func encodeEventCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+// decodeEventCursor - decode the id of event from token, it is empty for the first page
+func decodeEventCursor(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !eventIDRegex.Match(b) {
+		return "", ErrInvalidCursor
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,140 @@
+package score
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+// Test_GetAudit
+func (t *moderationSuite) Test_GetAudit() {
+	from, to := time.Unix(1760000000, 0), time.Unix(1760003600, int64(999*time.Millisecond))
+
+	adjust := &model.AuditEvent{ID: "1760000500000-1", Action: model.AuditAdjust, Actor: "ops", Board: model.DefaultBoard, ClientID: "adam"}
+	ban := &model.AuditEvent{ID: "1760000400000-0", Action: model.AuditBan, Actor: "ops", ClientID: "bob"}
+	create := &model.AuditEvent{ID: "1760000300000-0", Action: model.AuditCreate, Actor: "adam", Board: model.DefaultBoard, ClientID: "adam"}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		query      *GetAudit
+		wantResult *model.AuditPage
+		wantError  error
+	}{
+		{
+			name: "test get audit of player case",
+			fn: func() {
+				t.mockAuditRepository.EXPECT().ListPlayerAudit(gomock.Any(), "adam", from, to, "", int64(DefaultPageSize)).
+					Return([]*model.AuditEvent{adjust, create}, nil).Times(1)
+			},
+			query:      &GetAudit{ClientID: "adam", From: 1760000000, To: 1760003600},
+			wantResult: &model.AuditPage{Events: []*model.AuditEvent{adjust, create}},
+		},
+		{
+			name: "test get audit with next page case",
+			fn: func() {
+				t.mockAuditRepository.EXPECT().ListAudit(gomock.Any(), from, to, "", int64(2)).
+					Return([]*model.AuditEvent{adjust, ban}, nil).Times(1)
+			},
+			query:      &GetAudit{From: 1760000000, To: 1760003600, Limit: 2},
+			wantResult: &model.AuditPage{Events: []*model.AuditEvent{adjust, ban}, Next: encodeEventCursor(ban.ID)},
+		},
+		{
+			name: "test get audit of player continues after cursor case",
+			fn: func() {
+				t.mockAuditRepository.EXPECT().ListPlayerAudit(gomock.Any(), "adam", from, to, adjust.ID, int64(2)).
+					Return([]*model.AuditEvent{create}, nil).Times(1)
+			},
+			query:      &GetAudit{ClientID: "adam", From: 1760000000, To: 1760003600, Limit: 2, Next: encodeEventCursor(adjust.ID)},
+			wantResult: &model.AuditPage{Events: []*model.AuditEvent{create}},
+		},
+		{
+			name: "test get audit list failed case",
+			fn: func() {
+				t.mockAuditRepository.EXPECT().ListAudit(gomock.Any(), from, to, "", int64(DefaultPageSize)).
+					Return(nil, errors.New("failed")).Times(1)
+			},
+			query:     &GetAudit{From: 1760000000, To: 1760003600},
+			wantError: errors.New("failed"),
+		},
+		{
+			name:      "test get audit invalid range case",
+			fn:        func() {},
+			query:     &GetAudit{From: 1760003600, To: 1760000000},
+			wantError: ErrInvalidRange,
+		},
+		{
+			name:      "test get audit invalid cursor case",
+			fn:        func() {},
+			query:     &GetAudit{Next: "not-a-cursor!"},
+			wantError: ErrInvalidCursor,
+		},
+		{
+			name:      "test get audit invalid limit case",
+			fn:        func() {},
+			query:     &GetAudit{Limit: MaxPageSize + 1},
+			wantError: ErrInvalidPage,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.GetAudit(t.ctx, test.query)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_Audit
+func (t *moderationSuite) Test_Audit() {
+	previous := float64(90)
+
+	// the submission is audited with the origin of request
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), gomock.Any(), testBoard).
+		Return(&model.ScoreResult{ClientID: "adam", Score: 100, Previous: &previous}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), testBoard.ExpireTime()).Return(nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), testBoard).
+		Return(&model.ScoreResult{ClientID: "adam", Score: 100}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	score := float64(100)
+	t.expectAudit(&model.AuditEvent{Action: model.AuditCreate, Board: model.DefaultBoard, ClientID: "adam", Previous: &previous, Score: &score})
+
+	_, err := t.usecase.Add(t.ctx, &AddScore{Board: model.DefaultBoard, ClientID: "adam", Score: 100})
+	t.NoError(err)
+
+	// the duplicate submission is audited as well
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{}, nil).Times(1)
+	t.mockEntryRepository.EXPECT().CreateEntry(gomock.Any(), testBoard, gomock.Any(), testBoard.ExpireTime()).
+		Return(&model.Entry{EntryID: "e1", ClientID: "adam", Score: 100}, nil).Times(1)
+	t.expectAudit(&model.AuditEvent{Action: model.AuditCreate, Board: model.DefaultBoard, ClientID: "adam", Score: &score})
+
+	_, err = t.usecase.AddIgnoreDuplicate(t.ctx, &AddScore{Board: model.DefaultBoard, ClientID: "adam", Score: 100})
+	t.NoError(err)
+
+	// the scheduled reset has no request, it is made by the system
+	result := &model.ResetResult{}
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().Reset(gomock.Any(), testBoard, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(result, nil).Times(1)
+	t.mockAuditRepository.EXPECT().AppendAudit(gomock.Any(), gomock.Any(), int64(1000)).
+		DoAndReturn(func(ctx context.Context, events []*model.AuditEvent, max int64) error {
+			t.Len(events, 1)
+			t.Equal(model.AuditReset, events[0].Action)
+			t.Equal(model.SystemActor, events[0].Actor)
+			t.Equal(model.DefaultBoard, events[0].Board)
+			return errors.New("failed")
+		}).Times(1)
+
+	// the failed append does not fail the reset made before
+	got, err := t.usecase.ResetLeaderBoard(context.Background(), model.DefaultBoard)
+	t.NoError(err)
+	t.Equal(result, got)
+}
@@ -1,10 +1,69 @@
 package score
 
+import "encoding/json"
+
 // AddScore
 type AddScore struct {
+	// Board board id
+	Board string `json:"-"`
+
 	// ClientID client id
 	ClientID string
 
 	// Score
 	Score float64
+
+	// Metadata game context of the submission, it must be a JSON object
+	Metadata json.RawMessage
+
+	// Segments the player attributes tagged, e.g. {"country": "TW", "platform": "ios"}
+	Segments map[string]string
+}
+
+// AddScores
+type AddScores struct {
+	// Board board id
+	Board string `json:"-"`
+
+	// Scores the submissions, each is validated independently
+	Scores []*AddScore
+}
+
+// RemovePlayer
+type RemovePlayer struct {
+	// Board board id
+	Board string `json:"-"`
+
+	// ClientID client id
+	ClientID string `json:"-"`
+
+	// Reason why the player is removed, it is required
+	Reason string
+}
+
+// SetScore - set the score of player, or adjust it by delta, exactly one of them is set
+type SetScore struct {
+	// Board board id
+	Board string `json:"-"`
+
+	// ClientID client id
+	ClientID string `json:"-"`
+
+	// Score the score replacing the stored score
+	Score *float64
+
+	// Delta the amount added to the stored score, it is negative to deduct
+	Delta *float64
+
+	// Reason why the score is changed, it is required
+	Reason string
+}
+
+// BanPlayer
+type BanPlayer struct {
+	// ClientID client id
+	ClientID string `json:"-"`
+
+	// Reason why the client is banned or unbanned, it is required
+	Reason string
 }
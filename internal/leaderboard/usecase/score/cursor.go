@@ -0,0 +1,26 @@
+package score
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// encodeCursor - encode offset of next page to an opaque token
+func encodeCursor(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+// decodeCursor - decode offset from token
+func decodeCursor(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	offset, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return offset, nil
+}
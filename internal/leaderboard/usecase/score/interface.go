@@ -8,14 +8,56 @@ import (
 // ScoreUsecase -
 type ScoreUsecase interface {
 	// Add - add score
-	Add(ctx context.Context, command *AddScore) error
+	Add(ctx context.Context, command *AddScore) (*model.ScoreResult, error)
 
-	// AddIgnoreDuplicate
-	AddIgnoreDuplicate(ctx context.Context, command *AddScore) error
+	// AddBatch - add the scores of many clients, the result of each submission is in the order of submissions
+	AddBatch(ctx context.Context, command *AddScores) ([]*model.BatchResult, error)
 
-	// GetLeaderBoard
-	GetLeaderBoard(ctx context.Context) ([]*model.Score, error)
+	// AddIgnoreDuplicate - add score as a new entry, the same client can have many entries
+	AddIgnoreDuplicate(ctx context.Context, command *AddScore) (*model.Entry, error)
 
-	// ResetLeaderBoard
-	ResetLeaderBoard(ctx context.Context) error
+	// GetLeaderBoard - get one page of leaderboard
+	GetLeaderBoard(ctx context.Context, query *GetLeaderBoard) (*model.ScorePage, error)
+
+	// GetEntries - get one page of entries
+	GetEntries(ctx context.Context, query *GetLeaderBoard) (*model.EntryPage, error)
+
+	// GetPlayerRank - get score, rank and percentile of one client
+	GetPlayerRank(ctx context.Context, query *GetPlayer) (*model.PlayerRank, error)
+
+	// GetAroundPlayer - get the players ranked within radius of one client
+	GetAroundPlayer(ctx context.Context, query *GetPlayer) ([]*model.Score, error)
+
+	// GetFriends - get the client and its friends ranked among them
+	GetFriends(ctx context.Context, query *GetPlayer) ([]*model.Score, error)
+
+	// GetQuarantine - get one page of the submissions rejected by the rules of board
+	GetQuarantine(ctx context.Context, query *GetLeaderBoard) (*model.QuarantinePage, error)
+
+	// RefreshAggregate - materialise the aggregated board now
+	RefreshAggregate(ctx context.Context, board string) (int64, error)
+
+	// ListSeasons - list the archived seasons of board, the latest first
+	ListSeasons(ctx context.Context, board string) ([]*model.Season, error)
+
+	// GetSeason - get one page of the archived standings of season
+	GetSeason(ctx context.Context, query *GetSeason) (*model.ScorePage, error)
+
+	// ResetLeaderBoard - archive the standings of board as a new season, and clear the board
+	ResetLeaderBoard(ctx context.Context, board string) (*model.ResetResult, error)
+
+	// RemovePlayer - remove the player from board
+	RemovePlayer(ctx context.Context, command *RemovePlayer) error
+
+	// SetScore - set or adjust the score of player on board
+	SetScore(ctx context.Context, command *SetScore) (*model.ScoreResult, error)
+
+	// Ban - ban the client from submitting scores, and remove it from the boards
+	Ban(ctx context.Context, command *BanPlayer) (*model.Ban, error)
+
+	// Unban - lift the ban of client
+	Unban(ctx context.Context, command *BanPlayer) error
+
+	// GetAudit - query the audit log by player and time range, the latest first
+	GetAudit(ctx context.Context, query *GetAudit) (*model.AuditPage, error)
 }
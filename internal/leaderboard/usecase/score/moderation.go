@@ -0,0 +1,224 @@
+package score
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxReasonLength - the max characters of the reason of moderation
+	MaxReasonLength = 256
+)
+
+var (
+	// ErrInvalidReason -
+	ErrInvalidReason = errors.New("reason must have 1 to 256 characters")
+
+	// ErrInvalidAdjustment -
+	ErrInvalidAdjustment = errors.New("either score or delta must be set")
+)
+
+// RemovePlayer - remove the player from board, the boards of segments and the buckets of windows kept,
+// and recompute the team of player
+func (u *usecase) RemovePlayer(ctx context.Context, command *RemovePlayer) error {
+	if !validReason(command.Reason) {
+		return ErrInvalidReason
+	}
+
+	board, err := u.boardRepository.GetBoard(ctx, command.Board)
+	if err != nil {
+		return err
+	}
+
+	if board.Aggregated() {
+		return ErrAggregatedBoard
+	}
+
+	previous, err := u.leaderBoardRepository.Score(ctx, board.Key(), command.ClientID, board)
+	if err != nil {
+		return err
+	}
+
+	if err := u.remove(ctx, board, command.ClientID); err != nil {
+		return err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   model.AuditRemove,
+		Board:    board.ID,
+		ClientID: command.ClientID,
+		Previous: &previous,
+		Reason:   command.Reason,
+	})
+
+	return nil
+}
+
+// SetScore - replace the stored score of player, or add delta to it, regardless of the update policy of board.
+// Only the standings of board are changed, the boards of segments and windows are kept
+func (u *usecase) SetScore(ctx context.Context, command *SetScore) (*model.ScoreResult, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	if !validReason(command.Reason) {
+		return nil, ErrInvalidReason
+	}
+
+	if (command.Score == nil) == (command.Delta == nil) {
+		return nil, ErrInvalidAdjustment
+	}
+
+	board, err := u.boardRepository.GetBoard(ctx, command.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	if board.Aggregated() {
+		return nil, ErrAggregatedBoard
+	}
+
+	// the update policy of the copy decides how the score is changed
+	b, action, value := *board, model.AuditSet, command.Score
+	b.Update = model.UpdateLatest
+	if command.Delta != nil {
+		b.Update, action, value = model.UpdateIncrement, model.AuditAdjust, command.Delta
+	}
+
+	if !validScore(board, *value) {
+		return nil, ErrInvalidScore
+	}
+
+	if err := u.checkBan(ctx, command.ClientID); err != nil {
+		return nil, err
+	}
+
+	key := board.Key()
+
+	result, err := u.leaderBoardRepository.Create(ctx, key, &model.Score{ClientID: command.ClientID, Score: *value}, &b)
+	if err != nil {
+		return nil, err
+	}
+
+	if board.Reset == model.ResetTTL {
+		if err := u.leaderBoardRepository.SetExpire(ctx, key, board.ExpireTime()); err != nil {
+			return nil, err
+		}
+	}
+
+	if board.TeamsEnabled() && result.Changed {
+		if err := u.updateTeam(ctx, board, command.ClientID); err != nil {
+			return nil, err
+		}
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   action,
+		Board:    board.ID,
+		ClientID: command.ClientID,
+		Previous: result.Previous,
+		Score:    &result.Score,
+		Reason:   command.Reason,
+	})
+
+	return result, nil
+}
+
+// Ban - ban the client, and remove it from every board, the aggregated boards filter it out until they are materialised again
+func (u *usecase) Ban(ctx context.Context, command *BanPlayer) (*model.Ban, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	if !validReason(command.Reason) {
+		return nil, ErrInvalidReason
+	}
+
+	ban := &model.Ban{
+		ClientID:  command.ClientID,
+		Reason:    command.Reason,
+		Actor:     model.ActingOrigin(ctx).Actor,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	// the ban is saved first, so the scores submitted while removing are rejected
+	if err := u.banRepository.SaveBan(ctx, ban); err != nil {
+		return nil, err
+	}
+
+	boards, err := u.boardRepository.ListBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range boards {
+		if b.Aggregated() {
+			continue
+		}
+
+		if err := u.remove(ctx, b, command.ClientID); err != nil {
+			return nil, err
+		}
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   model.AuditBan,
+		ClientID: command.ClientID,
+		Reason:   command.Reason,
+	})
+
+	return ban, nil
+}
+
+// Unban - lift the ban of client, the scores removed by the ban are not restored
+func (u *usecase) Unban(ctx context.Context, command *BanPlayer) error {
+	if !validReason(command.Reason) {
+		return ErrInvalidReason
+	}
+
+	if err := u.banRepository.DeleteBan(ctx, command.ClientID); err != nil {
+		return err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   model.AuditUnban,
+		ClientID: command.ClientID,
+		Reason:   command.Reason,
+	})
+
+	return nil
+}
+
+// remove - remove the player from the keys of board, and recompute its team
+func (u *usecase) remove(ctx context.Context, board *model.Board, clientID string) error {
+	keys := []string{board.Key()}
+	for _, s := range u.segments {
+		keys = append(keys, board.SegmentKey(s))
+	}
+
+	now := time.Now().In(u.location)
+	for _, w := range u.windows {
+		for _, bucket := range w.Buckets(now, u.keep) {
+			keys = append(keys, board.WindowKey(w, bucket))
+		}
+	}
+
+	if err := u.leaderBoardRepository.Remove(ctx, keys, clientID); err != nil {
+		return err
+	}
+
+	if board.TeamsEnabled() {
+		return u.updateTeam(ctx, board, clientID)
+	}
+
+	return nil
+}
+
+// validReason - the reason is required and bounded
+func validReason(reason string) bool {
+	n := utf8.RuneCountInString(reason)
+	return n > 0 && n <= MaxReasonLength
+}
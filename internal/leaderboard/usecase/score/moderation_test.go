@@ -0,0 +1,444 @@
+package score
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/test/mock/repository"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// moderationSuite - the bans are checked strictly, so it has its own mocks
+type moderationSuite struct {
+	suite.Suite
+	ctrl                      *gomock.Controller
+	mockLeaderBoardRepository *repository.MockLeaderBoardRepository
+	mockBoardRepository       *repository.MockBoardRepository
+	mockEntryRepository       *repository.MockEntryRepository
+	mockPlayerRepository      *repository.MockPlayerRepository
+	mockTeamRepository        *repository.MockTeamRepository
+	mockBanRepository         *repository.MockBanRepository
+	mockAuditRepository       *repository.MockAuditRepository
+	usecase                   *usecase
+
+	// ctx the context of the request from admin
+	ctx context.Context
+}
+
+// SetupTest
+func (t *moderationSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockLeaderBoardRepository = repository.NewMockLeaderBoardRepository(t.ctrl)
+	t.mockBoardRepository = repository.NewMockBoardRepository(t.ctrl)
+	t.mockEntryRepository = repository.NewMockEntryRepository(t.ctrl)
+	t.mockPlayerRepository = repository.NewMockPlayerRepository(t.ctrl)
+	t.mockTeamRepository = repository.NewMockTeamRepository(t.ctrl)
+	t.mockBanRepository = repository.NewMockBanRepository(t.ctrl)
+	t.mockAuditRepository = repository.NewMockAuditRepository(t.ctrl)
+
+	t.ctx = model.WithOrigin(context.Background(), &model.Origin{Actor: "ops", IP: "10.0.0.1", RequestID: "req-1"})
+
+	t.usecase = &usecase{
+		leaderBoardRepository: t.mockLeaderBoardRepository,
+		boardRepository:       t.mockBoardRepository,
+		entryRepository:       t.mockEntryRepository,
+		playerRepository:      t.mockPlayerRepository,
+		teamRepository:        t.mockTeamRepository,
+		banRepository:         t.mockBanRepository,
+		auditRepository:       t.mockAuditRepository,
+		auditMaxLen:           1000,
+		logger:                zap.NewNop(),
+		windows:               []model.Window{model.WindowDaily},
+		location:              time.UTC,
+		keep:                  2,
+		segments: []model.Segment{
+			{Name: "country", Value: "TW"},
+		},
+	}
+}
+
+// TestModerationUsecase
+func TestModerationUsecase(t *testing.T) {
+	suite.Run(t, new(moderationSuite))
+}
+
+// expectAudit - expect the events appended to the audit log with the origin of the request from admin
+func (t *moderationSuite) expectAudit(want ...*model.AuditEvent) {
+	for _, e := range want {
+		e.Actor, e.IP, e.RequestID = "ops", "10.0.0.1", "req-1"
+	}
+
+	t.mockAuditRepository.EXPECT().AppendAudit(gomock.Any(), gomock.Any(), int64(1000)).
+		DoAndReturn(func(ctx context.Context, events []*model.AuditEvent, max int64) error {
+			for _, e := range events {
+				t.NotZero(e.CreatedAt)
+				e.CreatedAt = 0
+			}
+			t.Equal(want, events)
+			return nil
+		}).Times(1)
+}
+
+// Test_RemovePlayer
+func (t *moderationSuite) Test_RemovePlayer() {
+	now := time.Now().UTC()
+	buckets := model.WindowDaily.Buckets(now, 2)
+	keys := []string{
+		testBoard.Key(),
+		testBoard.SegmentKey(model.Segment{Name: "country", Value: "TW"}),
+		testBoard.WindowKey(model.WindowDaily, buckets[0]),
+		testBoard.WindowKey(model.WindowDaily, buckets[1]),
+	}
+	previous := float64(900)
+
+	tests := []struct {
+		name      string
+		fn        func()
+		command   *RemovePlayer
+		wantError error
+	}{
+		{
+			name: "test remove player case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), testBoard.Key(), "adam", testBoard).Return(previous, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Remove(gomock.Any(), keys, "adam").Return(nil).Times(1)
+				t.expectAudit(&model.AuditEvent{
+					Action:   model.AuditRemove,
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Previous: &previous,
+					Reason:   "speed hack",
+				})
+			},
+			command: &RemovePlayer{Board: model.DefaultBoard, ClientID: "adam", Reason: "speed hack"},
+		},
+		{
+			name: "test remove player recomputes team case",
+			fn: func() {
+				teams := &model.Board{ID: "clans", Order: model.OrderDesc, Reset: model.ResetNever, Teams: &model.Teams{}}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "clans").Return(teams, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), teams.Key(), "adam", teams).Return(previous, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Remove(gomock.Any(), gomock.Len(4), "adam").Return(nil).Times(1)
+				t.mockTeamRepository.EXPECT().GetPlayerTeam(gomock.Any(), "adam").Return("wolves", nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), teams, "wolves").Return(nil).Times(1)
+				t.mockAuditRepository.EXPECT().AppendAudit(gomock.Any(), gomock.Any(), int64(1000)).Return(nil).Times(1)
+			},
+			command: &RemovePlayer{Board: "clans", ClientID: "adam", Reason: "speed hack"},
+		},
+		{
+			name: "test remove player not on board case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), testBoard.Key(), "adam", testBoard).Return(float64(0), model.ErrPlayerNotFound).Times(1)
+			},
+			command:   &RemovePlayer{Board: model.DefaultBoard, ClientID: "adam", Reason: "speed hack"},
+			wantError: model.ErrPlayerNotFound,
+		},
+		{
+			name: "test remove player from aggregated board case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "combined").Return(&model.Board{ID: "combined", Aggregate: &model.Aggregate{}}, nil).Times(1)
+			},
+			command:   &RemovePlayer{Board: "combined", ClientID: "adam", Reason: "speed hack"},
+			wantError: ErrAggregatedBoard,
+		},
+		{
+			name:      "test remove player without reason case",
+			fn:        func() {},
+			command:   &RemovePlayer{Board: model.DefaultBoard, ClientID: "adam"},
+			wantError: ErrInvalidReason,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			err := t.usecase.RemovePlayer(t.ctx, test.command)
+			t.Equal(test.wantError, err)
+		})
+	}
+}
+
+// Test_SetScore
+func (t *moderationSuite) Test_SetScore() {
+	var (
+		score, delta, half = float64(500), float64(-400), 0.5
+		previous           = float64(900)
+	)
+
+	// the copy of board with the update policy of the change
+	updated := func(policy model.UpdatePolicy) *model.Board {
+		b := *testBoard
+		b.Update = policy
+		return &b
+	}
+
+	tieBreak := &model.Board{ID: "speedrun", Order: model.OrderAsc, Reset: model.ResetNever, Update: model.UpdateMin, TieBreak: model.TieBreakFirst}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		command    *SetScore
+		wantResult *model.ScoreResult
+		wantError  error
+	}{
+		{
+			name: "test set score case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), &model.Score{ClientID: "adam", Score: score}, updated(model.UpdateLatest)).
+					Return(&model.ScoreResult{ClientID: "adam", Score: score, Changed: true, Previous: &previous}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), testBoard.ExpireTime()).Return(nil).Times(1)
+				t.expectAudit(&model.AuditEvent{
+					Action:   model.AuditSet,
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Previous: &previous,
+					Score:    &score,
+					Reason:   "restore after rollback",
+				})
+			},
+			command:    &SetScore{Board: model.DefaultBoard, ClientID: "adam", Score: &score, Reason: "restore after rollback"},
+			wantResult: &model.ScoreResult{ClientID: "adam", Score: score, Changed: true, Previous: &previous},
+		},
+		{
+			name: "test adjust score case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), &model.Score{ClientID: "adam", Score: delta}, updated(model.UpdateIncrement)).
+					Return(&model.ScoreResult{ClientID: "adam", Score: score, Changed: true, Previous: &previous}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), testBoard.ExpireTime()).Return(nil).Times(1)
+				t.expectAudit(&model.AuditEvent{
+					Action:   model.AuditAdjust,
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Previous: &previous,
+					Score:    &score,
+					Reason:   "exploit refund",
+				})
+			},
+			command:    &SetScore{Board: model.DefaultBoard, ClientID: "adam", Delta: &delta, Reason: "exploit refund"},
+			wantResult: &model.ScoreResult{ClientID: "adam", Score: score, Changed: true, Previous: &previous},
+		},
+		{
+			name: "test set score of banned client case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{"adam": true}, nil).Times(1)
+			},
+			command:   &SetScore{Board: model.DefaultBoard, ClientID: "adam", Score: &score, Reason: "restore"},
+			wantError: ErrBanned,
+		},
+		{
+			name: "test adjust tie-break board by fraction case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "speedrun").Return(tieBreak, nil).Times(1)
+			},
+			command:   &SetScore{Board: "speedrun", ClientID: "adam", Delta: &half, Reason: "refund"},
+			wantError: ErrInvalidScore,
+		},
+		{
+			name: "test set score create error case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(map[string]bool{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+			},
+			command:   &SetScore{Board: model.DefaultBoard, ClientID: "adam", Score: &score, Reason: "restore"},
+			wantError: errors.New(""),
+		},
+		{
+			name:      "test set both score and delta case",
+			fn:        func() {},
+			command:   &SetScore{Board: model.DefaultBoard, ClientID: "adam", Score: &score, Delta: &delta, Reason: "restore"},
+			wantError: ErrInvalidAdjustment,
+		},
+		{
+			name:      "test set neither score nor delta case",
+			fn:        func() {},
+			command:   &SetScore{Board: model.DefaultBoard, ClientID: "adam", Reason: "restore"},
+			wantError: ErrInvalidAdjustment,
+		},
+		{
+			name:      "test set score without reason case",
+			fn:        func() {},
+			command:   &SetScore{Board: model.DefaultBoard, ClientID: "adam", Score: &score},
+			wantError: ErrInvalidReason,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.SetScore(t.ctx, test.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_Ban
+func (t *moderationSuite) Test_Ban() {
+	aggregated := &model.Board{ID: "combined", Aggregate: &model.Aggregate{}}
+	weekly := &model.Board{ID: "weekly", Order: model.OrderDesc, Reset: model.ResetNever}
+
+	tests := []struct {
+		name      string
+		fn        func()
+		command   *BanPlayer
+		wantError error
+	}{
+		{
+			name: "test ban case",
+			fn: func() {
+				t.mockBanRepository.EXPECT().SaveBan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, ban *model.Ban) error {
+						t.Equal("adam", ban.ClientID)
+						t.Equal("speed hack", ban.Reason)
+						t.Equal("ops", ban.Actor)
+						return nil
+					}).Times(1)
+
+				// the aggregated board is skipped
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{testBoard, aggregated, weekly}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Remove(gomock.Any(), gomock.Len(4), "adam").Return(nil).Times(2)
+				t.expectAudit(&model.AuditEvent{
+					Action:   model.AuditBan,
+					ClientID: "adam",
+					Reason:   "speed hack",
+				})
+			},
+			command: &BanPlayer{ClientID: "adam", Reason: "speed hack"},
+		},
+		{
+			name: "test ban save error case",
+			fn: func() {
+				t.mockBanRepository.EXPECT().SaveBan(gomock.Any(), gomock.Any()).Return(errors.New("")).Times(1)
+			},
+			command:   &BanPlayer{ClientID: "adam", Reason: "speed hack"},
+			wantError: errors.New(""),
+		},
+		{
+			name:      "test ban without reason case",
+			fn:        func() {},
+			command:   &BanPlayer{ClientID: "adam"},
+			wantError: ErrInvalidReason,
+		},
+		{
+			name:      "test ban without client case",
+			fn:        func() {},
+			command:   &BanPlayer{Reason: "speed hack"},
+			wantError: ErrInvalidClientID,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			ban, err := t.usecase.Ban(t.ctx, test.command)
+			t.Equal(test.wantError, err)
+			if err == nil {
+				t.Equal(test.command.ClientID, ban.ClientID)
+				t.NotZero(ban.CreatedAt)
+			}
+		})
+	}
+}
+
+// Test_Unban
+func (t *moderationSuite) Test_Unban() {
+	t.mockBanRepository.EXPECT().DeleteBan(gomock.Any(), "adam").Return(nil).Times(1)
+	t.expectAudit(&model.AuditEvent{
+		Action:   model.AuditUnban,
+		ClientID: "adam",
+		Reason:   "appeal accepted",
+	})
+	t.NoError(t.usecase.Unban(t.ctx, &BanPlayer{ClientID: "adam", Reason: "appeal accepted"}))
+
+	t.mockBanRepository.EXPECT().DeleteBan(gomock.Any(), "adam").Return(model.ErrBanNotFound).Times(1)
+	t.Equal(model.ErrBanNotFound, t.usecase.Unban(t.ctx, &BanPlayer{ClientID: "adam", Reason: "appeal accepted"}))
+}
+
+// Test_BannedClient
+func (t *moderationSuite) Test_BannedClient() {
+	banned := map[string]bool{"adam": true}
+
+	// the banned client can not submit
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(banned, nil).Times(1)
+
+	_, err := t.usecase.Add(t.ctx, &AddScore{Board: model.DefaultBoard, ClientID: "adam", Score: 100})
+	t.Equal(ErrBanned, err)
+
+	// the banned client of batch is rejected alone
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam", "bob"}).Return(banned, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), testBoard.Key(), []*model.Score{{ClientID: "bob", Score: 90}}, testBoard).
+		Return([]*model.ScoreResult{{ClientID: "bob", Score: 90, Changed: true}}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), testBoard.ExpireTime()).Return(nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), gomock.Any(), []*model.Score{{ClientID: "bob", Score: 90}}, testBoard).
+		Return([]*model.ScoreResult{{ClientID: "bob", Score: 90, Changed: true}}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	score := float64(90)
+	t.expectAudit(&model.AuditEvent{Action: model.AuditCreate, Board: model.DefaultBoard, ClientID: "bob", Score: &score})
+
+	results, err := t.usecase.AddBatch(t.ctx, &AddScores{
+		Board: model.DefaultBoard,
+		Scores: []*AddScore{
+			{ClientID: "adam", Score: 100},
+			{ClientID: "bob", Score: 90},
+		},
+	})
+	t.NoError(err)
+	t.Equal([]*model.BatchResult{
+		{ClientID: "adam", Error: ErrBanned.Error()},
+		{ClientID: "bob", Score: 90, Changed: true},
+	}, results)
+
+	// the banned player is filtered out of the leaderboard
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), int64(0), int64(9), testBoard).Return([]*model.Score{
+		{ClientID: "adam", Score: 100, Rank: 1},
+		{ClientID: "bob", Score: 90, Rank: 2},
+	}, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam", "bob"}).Return(banned, nil).Times(1)
+	t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), []string{"bob"}).Return(map[string]*model.Profile{}, nil).Times(1)
+	t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), testBoard.Key()).Return(int64(3), nil).Times(1)
+
+	page, err := t.usecase.GetLeaderBoard(t.ctx, &GetLeaderBoard{Board: model.DefaultBoard})
+	t.NoError(err)
+	t.Equal([]*model.Score{{ClientID: "bob", Score: 90, Rank: 2}}, page.Scores)
+	t.Equal(encodeCursor(2), page.Next)
+
+	// the next page of entries follows the entries of banned player, even when the page is all filtered out
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockEntryRepository.EXPECT().ListEntries(gomock.Any(), testBoard, int64(0), int64(9)).Return([]*model.Entry{
+		{EntryID: "e1", ClientID: "adam", Score: 100, Rank: 1},
+		{EntryID: "e2", ClientID: "adam", Score: 100, Rank: 2},
+	}, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam", "adam"}).Return(banned, nil).Times(1)
+	t.mockEntryRepository.EXPECT().CountEntries(gomock.Any(), testBoard).Return(int64(3), nil).Times(1)
+
+	entries, err := t.usecase.GetEntries(t.ctx, &GetLeaderBoard{Board: model.DefaultBoard})
+	t.NoError(err)
+	t.Empty(entries.Entries)
+	t.Equal(encodeCursor(2), entries.Next)
+
+	// the rank of banned player is not found
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), []string{"adam"}).Return(banned, nil).Times(1)
+
+	_, err = t.usecase.GetPlayerRank(t.ctx, &GetPlayer{Board: model.DefaultBoard, ClientID: "adam"})
+	t.Equal(model.ErrPlayerNotFound, err)
+}
@@ -0,0 +1,74 @@
+package score
+
+import "leaderboard/internal/leaderboard/domain/model"
+
+// GetLeaderBoard
+type GetLeaderBoard struct {
+	// Board board id
+	Board string
+
+	// Window time window, all-time when it is empty
+	Window model.Window
+
+	// Teams read the team standings of board instead of the players
+	Teams bool
+
+	// Segment read the players in the segment, e.g. country:TW, all players when it is empty
+	Segment string
+
+	// Offset 0-based offset of the first player
+	Offset int64
+
+	// Limit page size, default page size is used when it is 0
+	Limit int64
+
+	// Next cursor returned by previous page, it overrides Offset
+	Next string
+}
+
+// GetSeason
+type GetSeason struct {
+	GetLeaderBoard
+
+	// Season season id
+	Season int64
+}
+
+// GetPlayer
+type GetPlayer struct {
+	// Board board id
+	Board string
+
+	// Window time window, all-time when it is empty
+	Window model.Window
+
+	// Teams read the team standings of board instead of the players
+	Teams bool
+
+	// Segment read the players in the segment, e.g. country:TW, all players when it is empty
+	Segment string
+
+	// ClientID client id, it is the team id when Teams is set
+	ClientID string
+
+	// Radius the number of players above and below, only for around player
+	Radius int64
+}
+
+// GetAudit
+type GetAudit struct {
+	// ClientID the player changed, all the events when it is empty
+	ClientID string
+
+	// From unix seconds of the earliest event, inclusive
+	From int64
+
+	// To unix seconds of the latest event, inclusive, now when it is 0
+	To int64
+
+	// Limit page size, default page size is used when it is 0
+	Limit int64
+
+	// Next cursor returned by previous page
+	Next string
+}
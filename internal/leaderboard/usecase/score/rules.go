@@ -0,0 +1,140 @@
+package score
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"math"
+	"time"
+)
+
+const (
+	// MaxQuarantine - the max quarantined submissions kept for each board
+	MaxQuarantine = 1000
+)
+
+var (
+	// ErrScoreOutOfRange -
+	ErrScoreOutOfRange = errors.New("score is out of range")
+
+	// ErrDeltaTooLarge -
+	ErrDeltaTooLarge = errors.New("score changes too much")
+
+	// ErrTooManySubmissions -
+	ErrTooManySubmissions = errors.New("too many submissions")
+
+	// ErrNotMonotonic -
+	ErrNotMonotonic = errors.New("score can not decrease")
+)
+
+// screen - check the submission by the rules of board, the rejected submission is quarantined for review.
+// The entries are not compared with the stored score of player, so only the range and rate are checked
+func (u *usecase) screen(ctx context.Context, board *model.Board, command *AddScore, entry bool) error {
+	if board.Rules == nil {
+		return nil
+	}
+
+	reason, err := u.violation(ctx, board, command, entry)
+	if err != nil || reason == nil {
+		return err
+	}
+
+	if err := u.ruleRepository.Quarantine(ctx, board, &model.Quarantined{
+		ClientID:  command.ClientID,
+		Score:     command.Score,
+		Metadata:  command.Metadata,
+		Reason:    reason.Error(),
+		CreatedAt: time.Now().Unix(),
+	}, MaxQuarantine); err != nil {
+		return err
+	}
+
+	return reason
+}
+
+// violation - get the rule violated by the submission, the reason is nil when it passes
+func (u *usecase) violation(ctx context.Context, board *model.Board, command *AddScore, entry bool) (reason error, err error) {
+	rules := board.Rules
+
+	// every submission counts, the rejected ones included
+	if rules.RatePerMinute > 0 {
+		count, err := u.ruleRepository.CountSubmission(ctx, board, command.ClientID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		if count > rules.RatePerMinute {
+			return ErrTooManySubmissions, nil
+		}
+	}
+
+	if (rules.Min != nil && command.Score < *rules.Min) || (rules.Max != nil && command.Score > *rules.Max) {
+		return ErrScoreOutOfRange, nil
+	}
+
+	if entry || (rules.MaxDelta == 0 && !rules.Monotonic) {
+		return nil, nil
+	}
+
+	// the submitted score of increment board is the change itself
+	if board.Update == model.UpdateIncrement {
+		return changed(rules, 0, command.Score), nil
+	}
+
+	previous, err := u.leaderBoardRepository.Score(ctx, board.Key(), command.ClientID, board)
+	if errors.Is(err, model.ErrPlayerNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return changed(rules, previous, command.Score), nil
+}
+
+// GetQuarantine - get one page of the submissions rejected by the rules of board, the latest first
+func (u *usecase) GetQuarantine(ctx context.Context, query *GetLeaderBoard) (*model.QuarantinePage, error) {
+	offset, limit, err := page(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := u.boardRepository.GetBoard(ctx, query.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := u.ruleRepository.ListQuarantine(ctx, b, offset, offset+limit-1)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.ruleRepository.CountQuarantine(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.QuarantinePage{
+		Submissions: submissions,
+		Total:       total,
+	}
+
+	if next := offset + int64(len(submissions)); next < total {
+		result.Next = encodeCursor(next)
+	}
+
+	return result, nil
+}
+
+// changed - check the change from the previous score to the submitted score by the delta and monotonic rules
+func changed(rules *model.Rules, previous, score float64) error {
+	if rules.MaxDelta > 0 && math.Abs(score-previous) > rules.MaxDelta {
+		return ErrDeltaTooLarge
+	}
+
+	if rules.Monotonic && score < previous {
+		return ErrNotMonotonic
+	}
+
+	return nil
+}
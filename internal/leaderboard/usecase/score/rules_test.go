@@ -0,0 +1,185 @@
+package score
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"math"
+
+	"github.com/golang/mock/gomock"
+)
+
+// Test_Screen
+func (t *TestSuite) Test_Screen() {
+	min, max := float64(0), float64(1000)
+
+	ruled := func(update model.UpdatePolicy, rules *model.Rules) *model.Board {
+		return &model.Board{
+			ID:     "ranked",
+			Order:  model.OrderDesc,
+			Reset:  model.ResetNever,
+			Update: update,
+			Rules:  rules,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(board *model.Board)
+		board     *model.Board
+		command   *AddScore
+		entry     bool
+		wantError error
+	}{
+		{
+			name:    "test board without rules case",
+			fn:      func(board *model.Board) {},
+			board:   testBoard,
+			command: &AddScore{ClientID: "adam", Score: -1},
+		},
+		{
+			name: "test score within rules case",
+			fn: func(board *model.Board) {
+				t.mockRuleRepository.EXPECT().CountSubmission(gomock.Any(), board, "adam", gomock.Any()).Return(int64(3), nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), "adam", board).Return(float64(400), nil).Times(1)
+			},
+			board:   ruled(model.UpdateLatest, &model.Rules{Min: &min, Max: &max, MaxDelta: 200, RatePerMinute: 5, Monotonic: true}),
+			command: &AddScore{ClientID: "adam", Score: 500},
+		},
+		{
+			name: "test first score of player case",
+			fn: func(board *model.Board) {
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), "adam", board).Return(float64(0), model.ErrPlayerNotFound).Times(1)
+			},
+			board:   ruled(model.UpdateLatest, &model.Rules{MaxDelta: 200}),
+			command: &AddScore{ClientID: "adam", Score: 500},
+		},
+		{
+			name: "test score out of range case",
+			fn: func(board *model.Board) {
+				t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).
+					DoAndReturn(func(ctx context.Context, board *model.Board, submission *model.Quarantined, max int64) error {
+						t.Equal("adam", submission.ClientID)
+						t.Equal(1e308, submission.Score)
+						t.Equal(ErrScoreOutOfRange.Error(), submission.Reason)
+						return nil
+					}).Times(1)
+			},
+			board:     ruled(model.UpdateLatest, &model.Rules{Min: &min, Max: &max}),
+			command:   &AddScore{ClientID: "adam", Score: 1e308},
+			wantError: ErrScoreOutOfRange,
+		},
+		{
+			name: "test too many submissions case",
+			fn: func(board *model.Board) {
+				t.mockRuleRepository.EXPECT().CountSubmission(gomock.Any(), board, "adam", gomock.Any()).Return(int64(6), nil).Times(1)
+				t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).Return(nil).Times(1)
+			},
+			board:     ruled(model.UpdateLatest, &model.Rules{RatePerMinute: 5}),
+			command:   &AddScore{ClientID: "adam", Score: 10},
+			wantError: ErrTooManySubmissions,
+		},
+		{
+			name: "test delta too large case",
+			fn: func(board *model.Board) {
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), "adam", board).Return(float64(100), nil).Times(1)
+				t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).Return(nil).Times(1)
+			},
+			board:     ruled(model.UpdateMax, &model.Rules{MaxDelta: 200}),
+			command:   &AddScore{ClientID: "adam", Score: 301},
+			wantError: ErrDeltaTooLarge,
+		},
+		{
+			name: "test score decreases case",
+			fn: func(board *model.Board) {
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), "adam", board).Return(float64(100), nil).Times(1)
+				t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).Return(nil).Times(1)
+			},
+			board:     ruled(model.UpdateLatest, &model.Rules{Monotonic: true}),
+			command:   &AddScore{ClientID: "adam", Score: 99},
+			wantError: ErrNotMonotonic,
+		},
+		{
+			name: "test negative increment case",
+			fn: func(board *model.Board) {
+				t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).Return(nil).Times(1)
+			},
+			board:     ruled(model.UpdateIncrement, &model.Rules{Monotonic: true}),
+			command:   &AddScore{ClientID: "adam", Score: -5},
+			wantError: ErrNotMonotonic,
+		},
+		{
+			name:    "test entry is not compared with stored score case",
+			fn:      func(board *model.Board) {},
+			board:   ruled(model.UpdateLatest, &model.Rules{MaxDelta: 1, Monotonic: true}),
+			command: &AddScore{ClientID: "adam", Score: 500},
+			entry:   true,
+		},
+		{
+			name: "test count submission error case",
+			fn: func(board *model.Board) {
+				t.mockRuleRepository.EXPECT().CountSubmission(gomock.Any(), board, "adam", gomock.Any()).Return(int64(0), errors.New("")).Times(1)
+			},
+			board:     ruled(model.UpdateLatest, &model.Rules{RatePerMinute: 5}),
+			command:   &AddScore{ClientID: "adam", Score: 10},
+			wantError: errors.New(""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.board)
+
+			err := t.usecase.screen(context.Background(), test.board, test.command, test.entry)
+			t.Equal(test.wantError, err)
+		})
+	}
+}
+
+// Test_AddRejected
+func (t *TestSuite) Test_AddRejected() {
+	max := float64(1000)
+	board := &model.Board{
+		ID:     "ranked",
+		Reset:  model.ResetNever,
+		Update: model.UpdateMax,
+		Rules:  &model.Rules{Max: &max},
+	}
+
+	// nothing is recorded when the rules reject the submission
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+	t.mockRuleRepository.EXPECT().Quarantine(gomock.Any(), board, gomock.Any(), int64(MaxQuarantine)).Return(nil).Times(1)
+
+	got, err := t.usecase.Add(context.Background(), &AddScore{Board: board.ID, ClientID: "adam", Score: 5000})
+	t.Equal(ErrScoreOutOfRange, err)
+	t.Nil(got)
+
+	// the score must be finite
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+	got, err = t.usecase.Add(context.Background(), &AddScore{Board: board.ID, ClientID: "adam", Score: math.Inf(1)})
+	t.Equal(ErrInvalidScore, err)
+	t.Nil(got)
+}
+
+// Test_GetQuarantine
+func (t *TestSuite) Test_GetQuarantine() {
+	submissions := []*model.Quarantined{
+		{ClientID: "adam", Score: 5000, Reason: ErrScoreOutOfRange.Error(), CreatedAt: 1760000000},
+	}
+
+	t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+	t.mockRuleRepository.EXPECT().ListQuarantine(gomock.Any(), testBoard, int64(0), int64(0)).Return(submissions, nil).Times(1)
+	t.mockRuleRepository.EXPECT().CountQuarantine(gomock.Any(), testBoard).Return(int64(3), nil).Times(1)
+
+	got, err := t.usecase.GetQuarantine(context.Background(), &GetLeaderBoard{Board: model.DefaultBoard, Limit: 1})
+	t.NoError(err)
+	t.Equal(&model.QuarantinePage{
+		Submissions: submissions,
+		Total:       3,
+		Next:        encodeCursor(1),
+	}, got)
+
+	_, err = t.usecase.GetQuarantine(context.Background(), &GetLeaderBoard{Board: model.DefaultBoard, Limit: MaxPageSize + 1})
+	t.Equal(ErrInvalidPage, err)
+}
@@ -2,108 +2,1154 @@ package score
 
 import (
 	"context"
+	"errors"
+	"leaderboard/config"
 	"leaderboard/internal/leaderboard/domain/model"
 	"leaderboard/internal/leaderboard/domain/repository"
-	"leaderboard/pkg/encoder/json"
+	"math"
 	"time"
+
+	"go.uber.org/zap"
 )
 
 const (
-	key = "leaderboard"
+	// MaxRadius - the max radius of around player query
+	MaxRadius = 50
+
+	// DefaultPageSize - the page size of leaderboard when limit is not set
+	DefaultPageSize = 10
+
+	// MaxPageSize - the max page size of leaderboard
+	MaxPageSize = 100
+
+	// MaxBatchSize - the max number of submissions of one batch
+	MaxBatchSize = 100
+)
+
+var (
+	// ErrInvalidRadius -
+	ErrInvalidRadius = errors.New("invalid radius")
+
+	// ErrInvalidPage -
+	ErrInvalidPage = errors.New("invalid offset or limit")
+
+	// ErrInvalidCursor -
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrInvalidScore -
+	ErrInvalidScore = errors.New("invalid score")
+
+	// ErrInvalidClientID -
+	ErrInvalidClientID = errors.New("invalid client id")
+
+	// ErrInvalidBatch -
+	ErrInvalidBatch = errors.New("batch must have 1 to 100 scores")
+
+	// ErrInvalidWindow -
+	ErrInvalidWindow = errors.New("invalid window")
+
+	// ErrAggregatedBoard -
+	ErrAggregatedBoard = errors.New("scores can not be submitted to aggregated board")
+
+	// ErrNotAggregated -
+	ErrNotAggregated = errors.New("board is not aggregated")
+
+	// ErrInvalidSegment -
+	ErrInvalidSegment = errors.New("invalid segment")
+
+	// ErrNoTeams -
+	ErrNoTeams = errors.New("board does not rank teams")
+
+	// ErrBanned -
+	ErrBanned = errors.New("client is banned")
 )
 
+// WriteError - the error after the score of submission is written, the submission is recorded
+// in part, so it must not be retried as if nothing was written
+type WriteError struct {
+	Err error
+}
+
+// Error -
+func (e *WriteError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap -
+func (e *WriteError) Unwrap() error {
+	return e.Err
+}
+
+// written - wrap the error after the score is written
+func written(err error) error {
+	return &WriteError{Err: err}
+}
+
 type usecase struct {
 	leaderBoardRepository repository.LeaderBoardRepository
+	boardRepository       repository.BoardRepository
+	entryRepository       repository.EntryRepository
+	playerRepository      repository.PlayerRepository
+	seasonRepository      repository.SeasonRepository
+	teamRepository        repository.TeamRepository
+	ruleRepository        repository.RuleRepository
+	banRepository         repository.BanRepository
+	auditRepository       repository.AuditRepository
+	season                config.Season
+
+	// auditMaxLen about how many latest events the audit log keeps
+	auditMaxLen int64
+	logger      *zap.Logger
+
+	// windows the time windows every score fans out to, the location of their buckets,
+	// and the number of buckets kept
+	windows  []model.Window
+	location *time.Location
+	keep     int
+
+	// segments the allowed segments, the score is also recorded to the board of each segment tagged
+	segments []model.Segment
 }
 
 // NewUseCase -
-func NewUseCase(leaderBoardRepository repository.LeaderBoardRepository) ScoreUsecase {
+func NewUseCase(leaderBoardRepository repository.LeaderBoardRepository, boardRepository repository.BoardRepository, entryRepository repository.EntryRepository, playerRepository repository.PlayerRepository, seasonRepository repository.SeasonRepository, teamRepository repository.TeamRepository, ruleRepository repository.RuleRepository, banRepository repository.BanRepository, auditRepository repository.AuditRepository, conf config.Config, logger *zap.Logger) (ScoreUsecase, error) {
+	location, err := time.LoadLocation(conf.Window.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]model.Window, len(conf.Window.Windows))
+	for i, w := range conf.Window.Windows {
+		windows[i] = model.Window(w)
+		if !windows[i].Valid() || windows[i] == model.WindowAllTime {
+			return nil, ErrInvalidWindow
+		}
+	}
+
+	segments, ok := model.NewSegments(conf.Segment.Segments)
+	if !ok {
+		return nil, ErrInvalidSegment
+	}
+
 	return &usecase{
 		leaderBoardRepository: leaderBoardRepository,
-	}
+		boardRepository:       boardRepository,
+		entryRepository:       entryRepository,
+		playerRepository:      playerRepository,
+		seasonRepository:      seasonRepository,
+		teamRepository:        teamRepository,
+		ruleRepository:        ruleRepository,
+		banRepository:         banRepository,
+		auditRepository:       auditRepository,
+		season:                conf.Season,
+		auditMaxLen:           conf.Audit.MaxLen,
+		logger:                logger,
+		windows:               windows,
+		location:              location,
+		keep:                  conf.Window.Keep,
+		segments:              segments,
+	}, nil
 }
 
-// Add - add one score record
-func (u *usecase) Add(ctx context.Context, command *AddScore) error {
+// Add - add one score record by the update policy of board
+func (u *usecase) Add(ctx context.Context, command *AddScore) (*model.ScoreResult, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	board, err := u.boardRepository.GetBoard(ctx, command.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	if board.Aggregated() {
+		return nil, ErrAggregatedBoard
+	}
+
+	if !validScore(board, command.Score) {
+		return nil, ErrInvalidScore
+	}
+
+	if err := u.checkBan(ctx, command.ClientID); err != nil {
+		return nil, err
+	}
+
+	// check all the tags before recording, so the score is not recorded partially
+	segments, err := u.tagged(command.Segments)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.screen(ctx, board, command, false); err != nil {
+		return nil, err
+	}
+
 	in := &model.Score{
 		ClientID: command.ClientID,
 		Score:    command.Score,
+		Metadata: command.Metadata,
+	}
+
+	key := board.Key()
+
+	result, err := u.leaderBoardRepository.Create(ctx, key, in, board)
+	if err != nil {
+		return nil, err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   model.AuditCreate,
+		Board:    board.ID,
+		ClientID: in.ClientID,
+		Previous: result.Previous,
+		Score:    &result.Score,
+	})
+
+	// the TTL slides, every score extends the board reset by TTL
+	if board.Reset == model.ResetTTL {
+		if err := u.leaderBoardRepository.SetExpire(ctx, key, board.ExpireTime()); err != nil {
+			return nil, written(err)
+		}
+	}
+
+	// only the team of player is recomputed, and only when the stored score changes
+	if board.TeamsEnabled() && result.Changed {
+		if err := u.updateTeam(ctx, board, in.ClientID); err != nil {
+			return nil, written(err)
+		}
+	}
+
+	// the segment boards follow the board, they expire with it when it is reset by TTL
+	for _, s := range segments {
+		key := board.SegmentKey(s)
+
+		if _, err := u.leaderBoardRepository.Create(ctx, key, in, board); err != nil {
+			return nil, written(err)
+		}
+
+		if board.Reset == model.ResetTTL {
+			if err := u.leaderBoardRepository.SetExpire(ctx, key, board.ExpireTime()); err != nil {
+				return nil, written(err)
+			}
+		}
+	}
+
+	// fan out to the current bucket of every window, the bucket expires when the latest buckets kept end
+	now := time.Now().In(u.location)
+	for _, w := range u.windows {
+		bucket, _ := w.Bucket(now)
+		key := board.WindowKey(w, bucket)
+
+		if _, err := u.leaderBoardRepository.Create(ctx, key, in, board); err != nil {
+			return nil, written(err)
+		}
+
+		if err := u.leaderBoardRepository.SetExpire(ctx, key, w.Expire(now, u.keep).Sub(now)); err != nil {
+			return nil, written(err)
+		}
 	}
 
-	// check if key exists
-	setExpire := false
-	if u.leaderBoardRepository.Exists(ctx, key) == 0 {
-		setExpire = true
+	return result, nil
+}
+
+// AddBatch - add the scores of many clients by the update policy of board, every key is written in one pipeline.
+// The rejected submissions get their error in the results, and the others are still recorded
+func (u *usecase) AddBatch(ctx context.Context, command *AddScores) ([]*model.BatchResult, error) {
+	if len(command.Scores) == 0 || len(command.Scores) > MaxBatchSize {
+		return nil, ErrInvalidBatch
 	}
 
-	err := u.leaderBoardRepository.Create(ctx, key, in)
+	board, err := u.boardRepository.GetBoard(ctx, command.Board)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// If it is set TTL(10 minute)
-	if setExpire {
-		u.leaderBoardRepository.SetExpire(ctx, key, time.Minute*10)
+	if board.Aggregated() {
+		return nil, ErrAggregatedBoard
 	}
 
-	return nil
+	// the bans of all the clients are checked at once
+	ids := make([]string, len(command.Scores))
+	for i, item := range command.Scores {
+		ids[i] = item.ClientID
+	}
+
+	banned, err := u.banRepository.Banned(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results  = make([]*model.BatchResult, len(command.Scores))
+		accepted = []int{}
+		scores   = []*model.Score{}
+		tagged   = map[model.Segment][]*model.Score{}
+	)
+
+	for i, item := range command.Scores {
+		results[i] = &model.BatchResult{ClientID: item.ClientID}
+
+		if banned[item.ClientID] {
+			results[i].Error = ErrBanned.Error()
+			continue
+		}
+
+		segments, err := u.check(ctx, board, item)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		in := &model.Score{
+			ClientID: item.ClientID,
+			Score:    item.Score,
+			Metadata: item.Metadata,
+		}
+
+		accepted = append(accepted, i)
+		scores = append(scores, in)
+		for _, s := range segments {
+			tagged[s] = append(tagged[s], in)
+		}
+	}
+
+	if len(scores) == 0 {
+		return results, nil
+	}
+
+	key := board.Key()
+
+	stored, err := u.leaderBoardRepository.CreateBatch(ctx, key, scores, board)
+	if err != nil {
+		return nil, err
+	}
+
+	if board.Reset == model.ResetTTL {
+		if err := u.leaderBoardRepository.SetExpire(ctx, key, board.ExpireTime()); err != nil {
+			return nil, written(err)
+		}
+	}
+
+	changed := map[string]bool{}
+	events := make([]*model.AuditEvent, len(stored))
+	for j, r := range stored {
+		result := results[accepted[j]]
+		result.Score, result.Changed = r.Score, r.Changed
+
+		if r.Changed {
+			changed[r.ClientID] = true
+		}
+
+		score := r.Score
+		events[j] = &model.AuditEvent{
+			Action:   model.AuditCreate,
+			Board:    board.ID,
+			ClientID: r.ClientID,
+			Previous: r.Previous,
+			Score:    &score,
+		}
+	}
+
+	u.audit(ctx, events...)
+
+	// every team is recomputed once for each of its members changed
+	if board.TeamsEnabled() {
+		for _, in := range scores {
+			if !changed[in.ClientID] {
+				continue
+			}
+			delete(changed, in.ClientID)
+
+			if err := u.updateTeam(ctx, board, in.ClientID); err != nil {
+				return nil, written(err)
+			}
+		}
+	}
+
+	// the segments are written in the order of config
+	for _, s := range u.segments {
+		if len(tagged[s]) == 0 {
+			continue
+		}
+
+		key := board.SegmentKey(s)
+
+		if _, err := u.leaderBoardRepository.CreateBatch(ctx, key, tagged[s], board); err != nil {
+			return nil, written(err)
+		}
+
+		if board.Reset == model.ResetTTL {
+			if err := u.leaderBoardRepository.SetExpire(ctx, key, board.ExpireTime()); err != nil {
+				return nil, written(err)
+			}
+		}
+	}
+
+	now := time.Now().In(u.location)
+	for _, w := range u.windows {
+		bucket, _ := w.Bucket(now)
+		key := board.WindowKey(w, bucket)
+
+		if _, err := u.leaderBoardRepository.CreateBatch(ctx, key, scores, board); err != nil {
+			return nil, written(err)
+		}
+
+		if err := u.leaderBoardRepository.SetExpire(ctx, key, w.Expire(now, u.keep).Sub(now)); err != nil {
+			return nil, written(err)
+		}
+	}
+
+	return results, nil
 }
 
-// AddIgnoreDuplicate - the duplicate ClientID can appear on leaderboard
-func (u *usecase) AddIgnoreDuplicate(ctx context.Context, command *AddScore) error {
-	s := &model.Score{
-		ClientID:  command.ClientID,
-		CreatedAt: time.Now().Unix(),
+// AddIgnoreDuplicate - add score as a new entry, the same client can have many entries
+func (u *usecase) AddIgnoreDuplicate(ctx context.Context, command *AddScore) (*model.Entry, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	board, err := u.boardRepository.GetBoard(ctx, command.Board)
+	if err != nil {
+		return nil, err
 	}
 
-	coder := json.NewEncoder()
-	c, _ := coder.Encode(&s)
+	if board.Aggregated() {
+		return nil, ErrAggregatedBoard
+	}
 
-	in := &model.Score{
-		ClientID: string(c),
+	if !validScore(board, command.Score) {
+		return nil, ErrInvalidScore
+	}
+
+	if err := u.checkBan(ctx, command.ClientID); err != nil {
+		return nil, err
+	}
+
+	if err := u.screen(ctx, board, command, true); err != nil {
+		return nil, err
+	}
+
+	in := &model.Entry{
+		ClientID: command.ClientID,
 		Score:    command.Score,
+		Metadata: command.Metadata,
+	}
+
+	// only the board reset by TTL expires, every entry extends it
+	var ttl time.Duration
+	if board.Reset == model.ResetTTL {
+		ttl = board.ExpireTime()
+	}
+
+	entry, err := u.entryRepository.CreateEntry(ctx, board, in, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action:   model.AuditCreate,
+		Board:    board.ID,
+		ClientID: entry.ClientID,
+		Score:    &entry.Score,
+	})
+
+	return entry, nil
+}
+
+// GetLeaderBoard - get one page of leaderboard
+func (u *usecase) GetLeaderBoard(ctx context.Context, query *GetLeaderBoard) (*model.ScorePage, error) {
+	offset, limit, err := page(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := u.standings(ctx, query.Board, query.Teams, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := u.readKey(ctx, b, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := u.leaderBoardRepository.List(ctx, key, offset, offset+limit-1, b)
+	if err != nil {
+		return nil, err
+	}
+
+	// the next page follows the rows read, the banned players filtered out are still counted
+	fetched := int64(len(scores))
+
+	if err := u.rank(ctx, key, b, scores); err != nil {
+		return nil, err
+	}
+
+	if !query.Teams {
+		if scores, err = u.unbanned(ctx, scores); err != nil {
+			return nil, err
+		}
+
+		if err := u.profiles(ctx, scores); err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := u.leaderBoardRepository.Count(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ScorePage{
+		Scores: scores,
+		Total:  total,
+	}
+
+	if next := offset + fetched; next < total {
+		result.Next = encodeCursor(next)
+	}
+
+	return result, nil
+}
+
+// GetEntries - get one page of entries
+func (u *usecase) GetEntries(ctx context.Context, query *GetLeaderBoard) (*model.EntryPage, error) {
+	offset, limit, err := page(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := u.boardRepository.GetBoard(ctx, query.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := u.entryRepository.ListEntries(ctx, b, offset, offset+limit-1)
+	if err != nil {
+		return nil, err
+	}
+
+	// the next page follows the entries read, the entries of banned players filtered out are still counted
+	fetched := int64(len(entries))
+
+	if entries, err = u.unbannedEntries(ctx, entries); err != nil {
+		return nil, err
+	}
+
+	total, err := u.entryRepository.CountEntries(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.EntryPage{
+		Entries: entries,
+		Total:   total,
+	}
+
+	if next := offset + fetched; next < total {
+		result.Next = encodeCursor(next)
+	}
+
+	return result, nil
+}
+
+// GetPlayerRank - get score, rank and percentile of one client
+func (u *usecase) GetPlayerRank(ctx context.Context, query *GetPlayer) (*model.PlayerRank, error) {
+	b, err := u.standings(ctx, query.Board, query.Teams, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := u.readKey(ctx, b, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := query.ClientID
+
+	// the banned player is not on the board for the others
+	if !query.Teams {
+		if err := u.checkBan(ctx, clientID); err == ErrBanned {
+			return nil, model.ErrPlayerNotFound
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	score, err := u.leaderBoardRepository.Score(ctx, key, clientID, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rank        int64
+		approximate bool
+	)
+	if b.RankMode == model.RankShared || b.RankMode == model.RankDense {
+		rank, approximate, err = u.countBetter(ctx, key, b, score)
+	} else {
+		rank, err = u.leaderBoardRepository.Rank(ctx, key, clientID, b)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.leaderBoardRepository.Count(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.PlayerRank{
+		ClientID:    clientID,
+		Score:       score,
+		Rank:        rank + 1,
+		Approximate: approximate,
+		Total:       total,
+		Percentile:  percentile(rank+1, total),
+	}, nil
+}
+
+// GetAroundPlayer - get the players ranked within radius above and below one client
+func (u *usecase) GetAroundPlayer(ctx context.Context, query *GetPlayer) ([]*model.Score, error) {
+	radius := query.Radius
+	if radius < 0 || radius > MaxRadius {
+		return nil, ErrInvalidRadius
+	}
+
+	b, err := u.standings(ctx, query.Board, query.Teams, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := u.readKey(ctx, b, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := u.leaderBoardRepository.Rank(ctx, key, query.ClientID, b)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := rank - radius
+	if offset < 0 {
+		offset = 0
+	}
+
+	scores, err := u.leaderBoardRepository.List(ctx, key, offset, rank+radius, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.rank(ctx, key, b, scores); err != nil {
+		return nil, err
+	}
+
+	if !query.Teams {
+		if scores, err = u.unbanned(ctx, scores); err != nil {
+			return nil, err
+		}
+
+		if err := u.profiles(ctx, scores); err != nil {
+			return nil, err
+		}
+	}
+
+	return scores, nil
+}
+
+// GetFriends - get the client and its friends ranked among them by the scores of board,
+// the players without score are not in the result
+func (u *usecase) GetFriends(ctx context.Context, query *GetPlayer) ([]*model.Score, error) {
+	b, err := u.boardRepository.GetBoard(ctx, query.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := u.readKey(ctx, b, query.Window, query.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	friends, err := u.playerRepository.GetFriends(ctx, query.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := u.leaderBoardRepository.Scores(ctx, key, append(friends, query.ClientID), b)
+	if err != nil {
+		return nil, err
+	}
+
+	if scores, err = u.unbanned(ctx, scores); err != nil {
+		return nil, err
+	}
+
+	// the players left are ranked among themselves again
+	for i, s := range scores {
+		s.Rank = int64(i) + 1
+	}
+	renumber(b, scores)
+
+	if err := u.profiles(ctx, scores); err != nil {
+		return nil, err
+	}
+
+	return scores, nil
+}
+
+// RefreshAggregate - materialise the aggregated board now, and return the number of players
+func (u *usecase) RefreshAggregate(ctx context.Context, board string) (int64, error) {
+	b, err := u.boardRepository.GetBoard(ctx, board)
+	if err != nil {
+		return 0, err
+	}
+
+	if !b.Aggregated() {
+		return 0, ErrNotAggregated
+	}
+
+	return u.materialise(ctx, b)
+}
+
+// ListSeasons - list the archived seasons of board, the latest first
+func (u *usecase) ListSeasons(ctx context.Context, board string) ([]*model.Season, error) {
+	b, err := u.boardRepository.GetBoard(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.seasonRepository.ListSeasons(ctx, b)
+}
+
+// GetSeason - get one page of the archived standings of season, ranked as the board
+func (u *usecase) GetSeason(ctx context.Context, query *GetSeason) (*model.ScorePage, error) {
+	offset, limit, err := page(&query.GetLeaderBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := u.boardRepository.GetBoard(ctx, query.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := u.seasonRepository.GetSeason(ctx, b, query.Season)
+	if err != nil {
+		return nil, err
+	}
+
+	key := b.SeasonKey(season.ID)
+
+	scores, err := u.leaderBoardRepository.List(ctx, key, offset, offset+limit-1, b)
+	if err != nil {
+		return nil, err
+	}
+
+	// the next page follows the rows read, the banned players filtered out are still counted
+	fetched := int64(len(scores))
+
+	if err := u.rank(ctx, key, b, scores); err != nil {
+		return nil, err
+	}
+
+	if scores, err = u.unbanned(ctx, scores); err != nil {
+		return nil, err
+	}
+
+	if err := u.profiles(ctx, scores); err != nil {
+		return nil, err
+	}
+
+	result := &model.ScorePage{
+		Scores: scores,
+		Total:  season.Total,
+	}
+
+	if next := offset + fetched; next < season.Total {
+		result.Next = encodeCursor(next)
+	}
+
+	return result, nil
+}
+
+// ResetLeaderBoard - archive the standings of board as a new season and clear the entries,
+// the seasons beyond retention are pruned at the same time
+func (u *usecase) ResetLeaderBoard(ctx context.Context, board string) (*model.ResetResult, error) {
+	b, err := u.boardRepository.GetBoard(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+
+	// the aggregated board has no scores of its own to archive
+	if b.Aggregated() {
+		return nil, ErrAggregatedBoard
 	}
 
-	// check if key exists
-	setExpire := false
-	if u.leaderBoardRepository.Exists(ctx, key) == 0 {
-		setExpire = true
+	now := time.Now()
+
+	var before time.Time
+	if u.season.Retention > 0 {
+		before = now.Add(-u.season.Retention)
 	}
 
-	err := u.leaderBoardRepository.Create(ctx, key, in)
+	result, err := u.leaderBoardRepository.Reset(ctx, b, u.segments, now, u.season.Keep, before)
 	if err != nil {
+		return nil, err
+	}
+
+	u.audit(ctx, &model.AuditEvent{
+		Action: model.AuditReset,
+		Board:  b.ID,
+	})
+
+	return result, nil
+}
+
+// standings - get the board read, it is the team standings of board when teams is set,
+// which are only ranked all-time without segment
+func (u *usecase) standings(ctx context.Context, board string, teams bool, window model.Window, segment string) (*model.Board, error) {
+	b, err := u.boardRepository.GetBoard(ctx, board)
+	if err != nil || !teams {
+		return b, err
+	}
+
+	if !b.TeamsEnabled() {
+		return nil, ErrNoTeams
+	}
+
+	if window != "" && window != model.WindowAllTime {
+		return nil, ErrInvalidWindow
+	}
+
+	if segment != "" {
+		return nil, ErrInvalidSegment
+	}
+
+	return b.TeamBoard(), nil
+}
+
+// updateTeam - recompute the score of the team of player, the team standings expire with the board reset by TTL
+func (u *usecase) updateTeam(ctx context.Context, board *model.Board, clientID string) error {
+	team, err := u.teamRepository.GetPlayerTeam(ctx, clientID)
+	if err != nil || team == "" {
 		return err
 	}
 
-	// If it is set TTL(10 minute)
-	if setExpire {
-		u.leaderBoardRepository.SetExpire(ctx, key, time.Minute*10)
+	if err := u.teamRepository.UpdateTeamScore(ctx, board, team); err != nil {
+		return err
+	}
+
+	if board.Reset == model.ResetTTL {
+		return u.leaderBoardRepository.SetExpire(ctx, board.TeamsKey(), board.ExpireTime())
 	}
 
 	return nil
 }
 
-// GetLeaderBoard
-func (u *usecase) GetLeaderBoard(ctx context.Context) ([]*model.Score, error) {
-	// get leaderboard for top 10(0 - 9) with score
-	scores, err := u.leaderBoardRepository.List(ctx, key, 0, 9)
+// readKey - the sorted set key to read, the current bucket of window, the board of segment,
+// or the board itself when both are empty, and the aggregated board is materialised when its cache expired
+func (u *usecase) readKey(ctx context.Context, board *model.Board, window model.Window, segment string) (string, error) {
+	// the segments are only recorded all-time
+	if segment != "" {
+		s, ok := model.ParseSegment(segment)
+		if !ok || !u.allowed(s) || board.Aggregated() {
+			return "", ErrInvalidSegment
+		}
+
+		if window != "" && window != model.WindowAllTime {
+			return "", ErrInvalidWindow
+		}
+
+		return board.SegmentKey(s), nil
+	}
+
+	if board.Aggregated() {
+		if window != "" && window != model.WindowAllTime {
+			return "", ErrInvalidWindow
+		}
+
+		if u.leaderBoardRepository.Exists(ctx, board.Key()) == 0 {
+			if _, err := u.materialise(ctx, board); err != nil {
+				return "", err
+			}
+		}
+
+		return board.Key(), nil
+	}
+
+	if window == "" || window == model.WindowAllTime {
+		return board.Key(), nil
+	}
+
+	// only the windows fanned out have buckets
+	for _, w := range u.windows {
+		if w == window {
+			bucket, _ := w.Bucket(time.Now().In(u.location))
+			return board.WindowKey(w, bucket), nil
+		}
+	}
+
+	return "", ErrInvalidWindow
+}
+
+// check - validate one submission of batch by the rules of board, and get the segments of its tags
+func (u *usecase) check(ctx context.Context, board *model.Board, command *AddScore) ([]model.Segment, error) {
+	if !model.ValidID(command.ClientID) {
+		return nil, ErrInvalidClientID
+	}
+
+	if !validScore(board, command.Score) {
+		return nil, ErrInvalidScore
+	}
+
+	segments, err := u.tagged(command.Segments)
 	if err != nil {
 		return nil, err
 	}
 
-	coder := json.NewEncoder()
-	for i, v := range scores {
-		s := &model.Score{}
-		if err := coder.Decode([]byte(v.ClientID), &s); err != nil {
+	return segments, u.screen(ctx, board, command, false)
+}
+
+// tagged - get the segments of the tags, every tag must be allowed
+func (u *usecase) tagged(tags map[string]string) ([]model.Segment, error) {
+	segments := make([]model.Segment, 0, len(tags))
+	for name, value := range tags {
+		s := model.Segment{Name: name, Value: value}
+		if !u.allowed(s) {
+			return nil, ErrInvalidSegment
+		}
+		segments = append(segments, s)
+	}
+
+	return segments, nil
+}
+
+// allowed - check the segment is configured
+func (u *usecase) allowed(segment model.Segment) bool {
+	for _, s := range u.segments {
+		if s == segment {
+			return true
+		}
+	}
+
+	return false
+}
+
+// materialise - store the combination of the sources into the aggregated board, it expires after the cache TTL
+func (u *usecase) materialise(ctx context.Context, board *model.Board) (int64, error) {
+	aggregate := board.Aggregate
+	now := time.Now().In(u.location)
+
+	var (
+		keys    []string
+		weights []float64
+	)
+	for _, s := range aggregate.Sources {
+		source := &model.Board{ID: s.Board}
+
+		if s.Window == "" || s.Window == model.WindowAllTime {
+			keys = append(keys, source.Key())
+			weights = append(weights, s.Multiplier())
 			continue
 		}
-		scores[i].ClientID = s.ClientID
+
+		for _, bucket := range s.Window.Buckets(now, s.Last) {
+			keys = append(keys, source.WindowKey(s.Window, bucket))
+			weights = append(weights, s.Multiplier())
+		}
 	}
 
-	return scores, nil
+	ttl := time.Duration(aggregate.TTL) * time.Second
+	if aggregate.Op == model.AggregateIntersect {
+		return u.leaderBoardRepository.Intersect(ctx, board.Key(), keys, weights, aggregate.Func, ttl)
+	}
+
+	return u.leaderBoardRepository.Union(ctx, board.Key(), keys, weights, aggregate.Func, ttl)
+}
+
+// page - get the offset and limit of query, the cursor overrides offset
+func page(query *GetLeaderBoard) (offset, limit int64, err error) {
+	offset, limit = query.Offset, query.Limit
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	if query.Next != "" {
+		if offset, err = decodeCursor(query.Next); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if offset < 0 || limit < 0 || limit > MaxPageSize {
+		return 0, 0, ErrInvalidPage
+	}
+
+	return offset, limit, nil
+}
+
+// validScore - the score must be finite, and it is encoded with time when tie-break is enabled,
+// so it must be an integer within range
+func validScore(board *model.Board, score float64) bool {
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return false
+	}
+
+	if !board.TieBreakEnabled() {
+		return true
+	}
+
+	return score == math.Trunc(score) && math.Abs(score) < model.MaxTieBreakScore
 }
 
-// ResetLeaderBoard
-func (u *usecase) ResetLeaderBoard(ctx context.Context) error {
-	return u.leaderBoardRepository.DeleteAll(ctx)
+// rank - renumber the ranks of the sorted scores by the rank mode of board,
+// the ranks are ordinal when listing, so only shared and dense need it.
+// The ranks follow the first one, so they are all approximate when it is
+func (u *usecase) rank(ctx context.Context, key string, board *model.Board, scores []*model.Score) error {
+	if len(scores) == 0 || (board.RankMode != model.RankShared && board.RankMode != model.RankDense) {
+		return nil
+	}
+
+	better, approximate, err := u.countBetter(ctx, key, board, scores[0].Score)
+	if err != nil {
+		return err
+	}
+	scores[0].Rank = better + 1
+	renumber(board, scores)
+
+	for _, s := range scores {
+		s.Approximate = approximate
+	}
+
+	return nil
+}
+
+// renumber - renumber the ranks following the first one by the rank mode of board,
+// the equal scores share the rank in shared and dense modes
+func renumber(board *model.Board, scores []*model.Score) {
+	if board.RankMode != model.RankShared && board.RankMode != model.RankDense {
+		return
+	}
+
+	for i := 1; i < len(scores); i++ {
+		prev, cur := scores[i-1], scores[i]
+
+		switch {
+		case cur.Score == prev.Score:
+			cur.Rank = prev.Rank
+		case board.RankMode == model.RankDense:
+			cur.Rank = prev.Rank + 1
+		}
+	}
+}
+
+// profiles - join the profiles of players into the scores
+func (u *usecase) profiles(ctx context.Context, scores []*model.Score) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(scores))
+	for i, s := range scores {
+		ids[i] = s.ClientID
+	}
+
+	profiles, err := u.playerRepository.GetProfiles(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range scores {
+		s.Profile = profiles[s.ClientID]
+	}
+
+	return nil
+}
+
+// countBetter - count the players (shared) or the distinct scores (dense) better than score,
+// and whether the count is approximate, only the distinct scores of deep ranks are
+func (u *usecase) countBetter(ctx context.Context, key string, board *model.Board, score float64) (int64, bool, error) {
+	if board.RankMode == model.RankDense {
+		return u.leaderBoardRepository.CountDistinctBetter(ctx, key, score, board)
+	}
+
+	count, err := u.leaderBoardRepository.CountBetter(ctx, key, score, board)
+	return count, false, err
+}
+
+// percentile - the percentage of players ranked at or below the 1-based rank
+func percentile(rank, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	p := float64(total-rank+1) / float64(total) * 100
+
+	return math.Round(p*100) / 100
+}
+
+// checkBan - the banned client can not submit scores
+func (u *usecase) checkBan(ctx context.Context, clientID string) error {
+	banned, err := u.banRepository.Banned(ctx, []string{clientID})
+	if err != nil {
+		return err
+	}
+
+	if banned[clientID] {
+		return ErrBanned
+	}
+
+	return nil
+}
+
+// unbanned - filter the banned players out of the scores, the ranks of the others are kept
+func (u *usecase) unbanned(ctx context.Context, scores []*model.Score) ([]*model.Score, error) {
+	if len(scores) == 0 {
+		return scores, nil
+	}
+
+	ids := make([]string, len(scores))
+	for i, s := range scores {
+		ids[i] = s.ClientID
+	}
+
+	banned, err := u.banRepository.Banned(ctx, ids)
+	if err != nil || len(banned) == 0 {
+		return scores, err
+	}
+
+	result := make([]*model.Score, 0, len(scores))
+	for _, s := range scores {
+		if !banned[s.ClientID] {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+// unbannedEntries - filter the entries of the banned players out
+func (u *usecase) unbannedEntries(ctx context.Context, entries []*model.Entry) ([]*model.Entry, error) {
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ClientID
+	}
+
+	banned, err := u.banRepository.Banned(ctx, ids)
+	if err != nil || len(banned) == 0 {
+		return entries, err
+	}
+
+	result := make([]*model.Entry, 0, len(entries))
+	for _, e := range entries {
+		if !banned[e.ClientID] {
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
 }
@@ -2,7 +2,9 @@ package score
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"leaderboard/config"
 	"leaderboard/internal/leaderboard/domain/model"
 	"leaderboard/test/mock/repository"
 	"testing"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
 )
 
 // TestSuite
@@ -17,16 +20,63 @@ type TestSuite struct {
 	suite.Suite
 	ctrl                      *gomock.Controller
 	mockLeaderBoardRepository *repository.MockLeaderBoardRepository
+	mockBoardRepository       *repository.MockBoardRepository
+	mockEntryRepository       *repository.MockEntryRepository
+	mockPlayerRepository      *repository.MockPlayerRepository
+	mockSeasonRepository      *repository.MockSeasonRepository
+	mockTeamRepository        *repository.MockTeamRepository
+	mockRuleRepository        *repository.MockRuleRepository
+	mockBanRepository         *repository.MockBanRepository
+	mockAuditRepository       *repository.MockAuditRepository
 	usecase                   *usecase
 }
 
+var testBoard = &model.Board{
+	ID:     model.DefaultBoard,
+	Order:  model.OrderDesc,
+	Reset:  model.ResetTTL,
+	Update: model.UpdateMax,
+	TTL:    600,
+}
+
 // SetupTest
 func (t *TestSuite) SetupSuite() {
 	t.ctrl = gomock.NewController(t.T())
 	t.mockLeaderBoardRepository = repository.NewMockLeaderBoardRepository(t.ctrl)
+	t.mockBoardRepository = repository.NewMockBoardRepository(t.ctrl)
+	t.mockEntryRepository = repository.NewMockEntryRepository(t.ctrl)
+	t.mockPlayerRepository = repository.NewMockPlayerRepository(t.ctrl)
+	t.mockSeasonRepository = repository.NewMockSeasonRepository(t.ctrl)
+	t.mockTeamRepository = repository.NewMockTeamRepository(t.ctrl)
+	t.mockRuleRepository = repository.NewMockRuleRepository(t.ctrl)
+	t.mockBanRepository = repository.NewMockBanRepository(t.ctrl)
+	t.mockAuditRepository = repository.NewMockAuditRepository(t.ctrl)
+
+	// no client is banned and the audit log is not checked in the tests of the other features
+	t.mockBanRepository.EXPECT().Banned(gomock.Any(), gomock.Any()).Return(map[string]bool{}, nil).AnyTimes()
+	t.mockAuditRepository.EXPECT().AppendAudit(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	t.usecase = &usecase{
 		leaderBoardRepository: t.mockLeaderBoardRepository,
+		boardRepository:       t.mockBoardRepository,
+		entryRepository:       t.mockEntryRepository,
+		playerRepository:      t.mockPlayerRepository,
+		seasonRepository:      t.mockSeasonRepository,
+		teamRepository:        t.mockTeamRepository,
+		ruleRepository:        t.mockRuleRepository,
+		banRepository:         t.mockBanRepository,
+		auditRepository:       t.mockAuditRepository,
+		logger:                zap.NewNop(),
+		season: config.Season{
+			Keep:      10,
+			Retention: time.Hour,
+		},
+		location: time.UTC,
+		segments: []model.Segment{
+			{Name: "country", Value: "JP"},
+			{Name: "country", Value: "TW"},
+			{Name: "platform", Value: "ios"},
+		},
 	}
 }
 
@@ -47,143 +97,347 @@ func (t *TestSuite) Test_Add() {
 		fn        func(args)
 		args      args
 		wantError bool
+
+		// wantWritten the error is after the score is written
+		wantWritten bool
 	}{
 		{
-			name: "test add score when leaderboard is empty case",
+			name: "test add score extends TTL case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), &model.Score{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}, testBoard).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Changed:  true,
+				}, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), time.Minute*10).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    10.2,
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "test add score fans out to windows case",
+			fn: func(in args) {
+				t.usecase.windows = []model.Window{model.WindowDaily, model.WindowWeekly}
+
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				score := &model.Score{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), score, testBoard).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Changed:  true,
+				}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), time.Minute*10).Return(nil).Times(1)
+
+				now := time.Now().UTC()
+				for _, w := range t.usecase.windows {
+					bucket, _ := w.Bucket(now)
+					key := testBoard.WindowKey(w, bucket)
+
+					t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), key, score, testBoard).Return(&model.ScoreResult{}, nil).Times(1)
+					t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    10.2,
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "test add score to segment boards case",
 			fn: func(in args) {
-				var result int64 = 0
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), "leaderboard").Return(result).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), "leaderboard", &model.Score{
+				score := &model.Score{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), score, testBoard).Return(&model.ScoreResult{
 					ClientID: in.command.ClientID,
 					Score:    in.command.Score,
-				}).Return(nil).Times(1)
+					Changed:  true,
+				}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), time.Minute*10).Return(nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), "leaderboard", time.Minute*10).Times(1)
+				key := "board:default:segment:country:TW"
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), key, score, testBoard).Return(&model.ScoreResult{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), key, time.Minute*10).Return(nil).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
+					Board:    model.DefaultBoard,
 					ClientID: "adam",
 					Score:    10.2,
+					Segments: map[string]string{"country": "TW"},
 				},
 			},
 			wantError: false,
 		},
 		{
-			name: "test add score when leaderboard not empty case",
+			name: "test add score with segment not allowed case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    10.2,
+					Segments: map[string]string{"country": "TW", "platform": "switch"},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test add score set expire error case",
 			fn: func(in args) {
-				var result int64 = 1
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), "leaderboard").Return(result).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), "leaderboard", &model.Score{
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), &model.Score{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}, testBoard).Return(&model.ScoreResult{
 					ClientID: in.command.ClientID,
 					Score:    in.command.Score,
-				}).Return(nil).Times(1)
+					Changed:  true,
+				}, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), time.Minute*10).Return(errors.New("")).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
+					Board:    model.DefaultBoard,
 					ClientID: "peter",
 					Score:    91.2,
 				},
 			},
-			wantError: false,
+			wantError:   true,
+			wantWritten: true,
+		},
+		{
+			name: "test add score invalid client id case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "peter:friends",
+					Score:    91.2,
+				},
+			},
+			wantError: true,
 		},
 		{
 			name: "test add score error case",
 			fn: func(in args) {
-				var result int64 = 1
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), "leaderboard").Return(result).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), "leaderboard", &model.Score{
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), testBoard.Key(), &model.Score{
 					ClientID: in.command.ClientID,
 					Score:    in.command.Score,
-				}).Return(errors.New("")).Times(1)
+				}, testBoard).Return(nil, errors.New("")).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
+					Board:    model.DefaultBoard,
 					ClientID: "Linda",
 					Score:    91.2,
 				},
 			},
 			wantError: true,
 		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func() {
-			test.fn(test.args)
-
-			got := t.usecase.Add(test.args.ctx, test.args.command)
-			t.Equal(test.wantError, got != nil)
+		{
+			name: "test add score board not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "unknown",
+					ClientID: "Linda",
+					Score:    91.2,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test add score to board never reset case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:     "forever",
+					Reset:  model.ResetNever,
+					Update: model.UpdateIncrement,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
 
-		})
-	}
-}
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), board.Key(), &model.Score{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Metadata: in.command.Metadata,
+				}, board).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Changed:  true,
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "forever",
+					ClientID: "Linda",
+					Score:    91.2,
+					Metadata: json.RawMessage(`{"level":3}`),
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "test add score updates the team case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:     "clans",
+					Reset:  model.ResetTTL,
+					Update: model.UpdateMax,
+					TTL:    60,
+					Teams:  &model.Teams{Top: 3},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
 
-// Test_AddIgnoreDuplicate
-func (t *TestSuite) Test_AddIgnoreDuplicate() {
-	type args struct {
-		ctx     context.Context
-		command *AddScore
-	}
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), board.Key(), gomock.Any(), board).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Changed:  true,
+				}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), board.Key(), time.Minute).Return(nil).Times(1)
 
-	tests := []struct {
-		name      string
-		fn        func(args)
-		args      args
-		wantError bool
-	}{
+				t.mockTeamRepository.EXPECT().GetPlayerTeam(gomock.Any(), in.command.ClientID).Return("wolves", nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), board, "wolves").Return(nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), board.TeamsKey(), time.Minute).Return(nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "clans",
+					ClientID: "adam",
+					Score:    50,
+				},
+			},
+			wantError: false,
+		},
 		{
-			name: "test add score when leaderboard is empty case",
+			name: "test add unchanged score skips the team case",
 			fn: func(in args) {
-				var result int64 = 0
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), key).Return(result).Times(1)
-
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
+				board := &model.Board{
+					ID:     "clans",
+					Reset:  model.ResetNever,
+					Update: model.UpdateMax,
+					Teams:  &model.Teams{},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), key, time.Minute*10).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), board.Key(), gomock.Any(), board).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    80,
+				}, nil).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
+					Board:    "clans",
 					ClientID: "adam",
-					Score:    10.2,
+					Score:    50,
 				},
 			},
 			wantError: false,
 		},
 		{
-			name: "test add score when leaderboard not empty case",
+			name: "test add score of player without team case",
 			fn: func(in args) {
-				var result int64 = 1
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), key).Return(result).Times(1)
+				board := &model.Board{
+					ID:     "clans",
+					Reset:  model.ResetNever,
+					Update: model.UpdateMax,
+					Teams:  &model.Teams{},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
 
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), board.Key(), gomock.Any(), board).Return(&model.ScoreResult{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+					Changed:  true,
+				}, nil).Times(1)
+				t.mockTeamRepository.EXPECT().GetPlayerTeam(gomock.Any(), in.command.ClientID).Return("", nil).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
+					Board:    "clans",
 					ClientID: "peter",
-					Score:    91.2,
+					Score:    50,
 				},
 			},
 			wantError: false,
 		},
 		{
-			name: "test add score error case",
+			name: "test add score to aggregated board case",
 			fn: func(in args) {
-				var result int64 = 1
-				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), key).Return(result).Times(1)
-
-				t.mockLeaderBoardRepository.EXPECT().Create(gomock.Any(), key, gomock.Any()).Return(errors.New("")).Times(1)
+				board := &model.Board{
+					ID:        "modes",
+					Reset:     model.ResetNever,
+					Aggregate: &model.Aggregate{},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
 				command: &AddScore{
-					ClientID: "John",
+					Board:    "modes",
+					ClientID: "Linda",
+					Score:    91.2,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test add fractional score to tie-break board case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					TieBreak: model.TieBreakFirst,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "arena",
+					ClientID: "Linda",
 					Score:    91.2,
 				},
 			},
@@ -195,132 +449,1448 @@ func (t *TestSuite) Test_AddIgnoreDuplicate() {
 		t.Run(test.name, func() {
 			test.fn(test.args)
 
-			got := t.usecase.AddIgnoreDuplicate(test.args.ctx, test.args.command)
-			t.Equal(test.wantError, got != nil)
+			got, err := t.usecase.Add(test.args.ctx, test.args.command)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantError, got == nil)
 
+			var written *WriteError
+			t.Equal(test.wantWritten, errors.As(err, &written))
+
+			t.usecase.windows = nil
 		})
 	}
 }
 
-// Test_GetLeaderBoard
-func (t *TestSuite) Test_GetLeaderBoard() {
-	type args struct {
-		ctx context.Context
-	}
-
+// Test_AddBatch
+func (t *TestSuite) Test_AddBatch() {
 	tests := []struct {
 		name       string
-		fn         func(args)
-		args       args
-		wantResult []*model.Score
+		fn         func()
+		command    *AddScores
+		wantResult []*model.BatchResult
+		wantError  error
 	}{
 		{
-			name: "test get leaderboard success case",
-			fn: func(in args) {
-				var (
-					offset int64 = 0
-					limit  int64 = 9
-				)
-				result := []*model.Score{
-					{
-						ClientID: "adam",
-						Score:    100,
-					},
-					{
-						ClientID: "peter",
-						Score:    90,
-					},
-				}
-				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), key, offset, limit).Return(result, nil).Times(1)
-			},
-			args: args{
-				ctx: context.Background(),
+			name: "test add batch fans out the accepted scores case",
+			fn: func() {
+				t.usecase.windows = []model.Window{model.WindowDaily}
+
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				adam := &model.Score{ClientID: "adam", Score: 30}
+				bob := &model.Score{ClientID: "bob", Score: 20}
+				t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), testBoard.Key(), []*model.Score{adam, bob}, testBoard).Return([]*model.ScoreResult{
+					{ClientID: "adam", Score: 30, Changed: true},
+					{ClientID: "bob", Score: 25, Changed: false},
+				}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), testBoard.Key(), time.Minute*10).Return(nil).Times(1)
+
+				key := "board:default:segment:country:TW"
+				t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), key, []*model.Score{bob}, testBoard).Return([]*model.ScoreResult{{}}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), key, time.Minute*10).Return(nil).Times(1)
+
+				bucket, _ := model.WindowDaily.Bucket(time.Now().UTC())
+				key = testBoard.WindowKey(model.WindowDaily, bucket)
+				t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), key, []*model.Score{adam, bob}, testBoard).Return([]*model.ScoreResult{{}, {}}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().SetExpire(gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
 			},
-			wantResult: []*model.Score{
-				{
-					ClientID: "adam",
-					Score:    100,
-				},
-				{
-					ClientID: "peter",
-					Score:    90,
+			command: &AddScores{
+				Board: model.DefaultBoard,
+				Scores: []*AddScore{
+					{ClientID: "adam", Score: 30},
+					{Score: 10},
+					{ClientID: "bob", Score: 20, Segments: map[string]string{"country": "TW"}},
+					{ClientID: "carol", Score: 20, Segments: map[string]string{"country": "FR"}},
 				},
 			},
+			wantResult: []*model.BatchResult{
+				{ClientID: "adam", Score: 30, Changed: true},
+				{Error: ErrInvalidClientID.Error()},
+				{ClientID: "bob", Score: 25, Changed: false},
+				{ClientID: "carol", Error: ErrInvalidSegment.Error()},
+			},
 		},
 		{
-			name: "test get leaderboard success case",
-			fn: func(in args) {
-				var (
-					offset int64 = 0
-					limit  int64 = 9
-				)
-				result := []*model.Score{
-					{
-						ClientID: `{"clientId":"linda","createdAt":1}`,
-						Score:    100,
-					},
-					{
-						ClientID: "peter",
-						Score:    90,
-					},
-					{
-						ClientID: `{"clientId":"adam","createdAt":2}`,
-						Score:    8.2,
-					},
+			name: "test add batch updates the teams of changed players case",
+			fn: func() {
+				board := &model.Board{
+					ID:     "clans",
+					Reset:  model.ResetNever,
+					Update: model.UpdateMax,
+					Teams:  &model.Teams{Top: 3},
 				}
-				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), key, offset, limit).Return(result, nil).Times(1)
-			},
-			args: args{
-				ctx: context.Background(),
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), board.Key(), gomock.Any(), board).Return([]*model.ScoreResult{
+					{ClientID: "adam", Score: 30, Changed: true},
+					{ClientID: "bob", Score: 25, Changed: false},
+					{ClientID: "adam", Score: 40, Changed: true},
+				}, nil).Times(1)
+
+				t.mockTeamRepository.EXPECT().GetPlayerTeam(gomock.Any(), "adam").Return("wolves", nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), board, "wolves").Return(nil).Times(1)
 			},
-			wantResult: []*model.Score{
-				{
-					ClientID: "linda",
-					Score:    100,
-				},
-				{
-					ClientID: "peter",
-					Score:    90,
-				},
-				{
-					ClientID: "adam",
-					Score:    8.2,
+			command: &AddScores{
+				Board: "clans",
+				Scores: []*AddScore{
+					{ClientID: "adam", Score: 30},
+					{ClientID: "bob", Score: 20},
+					{ClientID: "adam", Score: 40},
 				},
 			},
+			wantResult: []*model.BatchResult{
+				{ClientID: "adam", Score: 30, Changed: true},
+				{ClientID: "bob", Score: 25, Changed: false},
+				{ClientID: "adam", Score: 40, Changed: true},
+			},
+		},
+		{
+			name: "test add batch all rejected case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			command: &AddScores{
+				Board:  model.DefaultBoard,
+				Scores: []*AddScore{{Score: 10}},
+			},
+			wantResult: []*model.BatchResult{
+				{Error: ErrInvalidClientID.Error()},
+			},
+		},
+		{
+			name: "test add batch error case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().CreateBatch(gomock.Any(), testBoard.Key(), gomock.Any(), testBoard).Return(nil, errors.New("")).Times(1)
+			},
+			command: &AddScores{
+				Board:  model.DefaultBoard,
+				Scores: []*AddScore{{ClientID: "adam", Score: 10}},
+			},
+			wantError: errors.New(""),
+		},
+		{
+			name: "test add batch to aggregated board case",
+			fn: func() {
+				board := &model.Board{ID: "modes", Aggregate: &model.Aggregate{}}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+			},
+			command: &AddScores{
+				Board:  "modes",
+				Scores: []*AddScore{{ClientID: "adam", Score: 10}},
+			},
+			wantError: ErrAggregatedBoard,
+		},
+		{
+			name:      "test empty batch case",
+			fn:        func() {},
+			command:   &AddScores{Board: model.DefaultBoard},
+			wantError: ErrInvalidBatch,
+		},
+		{
+			name: "test batch too large case",
+			fn:   func() {},
+			command: &AddScores{
+				Board:  model.DefaultBoard,
+				Scores: make([]*AddScore, MaxBatchSize+1),
+			},
+			wantError: ErrInvalidBatch,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func() {
-			test.fn(test.args)
+			test.fn()
 
-			got, _ := t.usecase.GetLeaderBoard(test.args.ctx)
+			got, err := t.usecase.AddBatch(context.Background(), test.command)
+			t.Equal(test.wantError, err)
 			t.Equal(test.wantResult, got)
+
+			t.usecase.windows = nil
 		})
 	}
 }
 
-// Test_ResetLeaderBoard
-func (t *TestSuite) Test_ResetLeaderBoard() {
+// Test_AddIgnoreDuplicate
+func (t *TestSuite) Test_AddIgnoreDuplicate() {
 	type args struct {
-		ctx context.Context
+		ctx     context.Context
+		command *AddScore
 	}
 
 	tests := []struct {
-		name      string
-		fn        func(args)
-		args      args
-		wantError bool
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.Entry
+		wantError  bool
+	}{
+		{
+			name: "test add entry to board reset by TTL case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockEntryRepository.EXPECT().CreateEntry(gomock.Any(), testBoard, &model.Entry{
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}, time.Minute*10).Return(&model.Entry{
+					EntryID:   "1",
+					ClientID:  in.command.ClientID,
+					Score:     in.command.Score,
+					CreatedAt: 1664553600,
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "adam",
+					Score:    10.2,
+				},
+			},
+			wantResult: &model.Entry{
+				EntryID:   "1",
+				ClientID:  "adam",
+				Score:     10.2,
+				CreatedAt: 1664553600,
+			},
+		},
+		{
+			name: "test add entry to board never reset case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:    "forever",
+					Reset: model.ResetNever,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				t.mockEntryRepository.EXPECT().CreateEntry(gomock.Any(), board, gomock.Any(), time.Duration(0)).Return(&model.Entry{
+					EntryID:  "7",
+					ClientID: in.command.ClientID,
+					Score:    in.command.Score,
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "forever",
+					ClientID: "peter",
+					Score:    91.2,
+				},
+			},
+			wantResult: &model.Entry{
+				EntryID:  "7",
+				ClientID: "peter",
+				Score:    91.2,
+			},
+		},
+		{
+			name: "test add fractional entry to tie-break board case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					TieBreak: model.TieBreakLast,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    "arena",
+					ClientID: "peter",
+					Score:    91.2,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "test add entry error case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockEntryRepository.EXPECT().CreateEntry(gomock.Any(), testBoard, gomock.Any(), time.Minute*10).Return(nil, errors.New("")).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				command: &AddScore{
+					Board:    model.DefaultBoard,
+					ClientID: "John",
+					Score:    91.2,
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.AddIgnoreDuplicate(test.args.ctx, test.args.command)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_GetEntries
+func (t *TestSuite) Test_GetEntries() {
+	type args struct {
+		ctx   context.Context
+		query *GetLeaderBoard
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.EntryPage
+		wantError  error
+	}{
+		{
+			name: "test get entries success case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					start int64 = 0
+					stop  int64 = 1
+					total int64 = 3
+				)
+				result := []*model.Entry{
+					{EntryID: "2", ClientID: "adam", Score: 100, Rank: 1},
+					{EntryID: "1", ClientID: "adam", Score: 90, Rank: 2},
+				}
+				t.mockEntryRepository.EXPECT().ListEntries(gomock.Any(), testBoard, start, stop).Return(result, nil).Times(1)
+				t.mockEntryRepository.EXPECT().CountEntries(gomock.Any(), testBoard).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+					Limit: 2,
+				},
+			},
+			wantResult: &model.EntryPage{
+				Entries: []*model.Entry{
+					{EntryID: "2", ClientID: "adam", Score: 100, Rank: 1},
+					{EntryID: "1", ClientID: "adam", Score: 90, Rank: 2},
+				},
+				Total: 3,
+				Next:  encodeCursor(2),
+			},
+		},
+		{
+			name: "test get entries with invalid limit case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+					Limit: MaxPageSize + 1,
+				},
+			},
+			wantError: ErrInvalidPage,
+		},
+		{
+			name: "test get entries board not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: "unknown",
+				},
+			},
+			wantError: model.ErrBoardNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.GetEntries(test.args.ctx, test.args.query)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_GetLeaderBoard
+func (t *TestSuite) Test_GetLeaderBoard() {
+	two := float64(2)
+
+	type args struct {
+		ctx   context.Context
+		query *GetLeaderBoard
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.ScorePage
+		wantError  error
+	}{
+		{
+			name: "test get leaderboard of aggregated board case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:    "modes",
+					Order: model.OrderDesc,
+					Reset: model.ResetNever,
+					Aggregate: &model.Aggregate{
+						Op:   model.AggregateUnion,
+						Func: model.AggregateMax,
+						Sources: []*model.Source{
+							{Board: "racing", Window: model.WindowAllTime},
+							{Board: "arena", Window: model.WindowAllTime, Weight: &two},
+						},
+						TTL: 60,
+					},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				// the cache expired
+				t.mockLeaderBoardRepository.EXPECT().Exists(gomock.Any(), board.Key()).Return(int64(0)).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Union(gomock.Any(), board.Key(), []string{"board:racing", "board:arena"}, []float64{1, 2}, model.AggregateMax, time.Minute).
+					Return(int64(1), nil).Times(1)
+
+				var (
+					start int64 = 0
+					stop  int64 = 9
+					total int64 = 1
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 200, Rank: 1},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), board.Key(), start, stop, board).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), []string{"adam"}).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: "modes",
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{ClientID: "adam", Score: 200, Rank: 1},
+				},
+				Total: 1,
+			},
+		},
+		{
+			name: "test get window of aggregated board case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:        "modes",
+					Aggregate: &model.Aggregate{},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board:  "modes",
+					Window: model.WindowDaily,
+				},
+			},
+			wantError: ErrInvalidWindow,
+		},
+		{
+			name: "test get leaderboard with shared rank case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					RankMode: model.RankShared,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				var (
+					start int64 = 0
+					stop  int64 = 9
+					total int64 = 3
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 100, Rank: 1},
+					{ClientID: "peter", Score: 100, Rank: 2},
+					{ClientID: "linda", Score: 90, Rank: 3},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), board.Key(), start, stop, board).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().CountBetter(gomock.Any(), board.Key(), float64(100), board).Return(int64(0), nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: "arena",
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{ClientID: "adam", Score: 100, Rank: 1},
+					{ClientID: "peter", Score: 100, Rank: 1},
+					{ClientID: "linda", Score: 90, Rank: 3},
+				},
+				Total: 3,
+			},
+		},
+		{
+			name: "test get leaderboard with dense rank case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					RankMode: model.RankDense,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				var (
+					start int64 = 2
+					stop  int64 = 4
+					total int64 = 6
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 80, Rank: 3},
+					{ClientID: "peter", Score: 80, Rank: 4},
+					{ClientID: "linda", Score: 70, Rank: 5},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), board.Key(), start, stop, board).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().CountDistinctBetter(gomock.Any(), board.Key(), float64(80), board).Return(int64(1), false, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board:  "arena",
+					Offset: 2,
+					Limit:  3,
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{ClientID: "adam", Score: 80, Rank: 2},
+					{ClientID: "peter", Score: 80, Rank: 2},
+					{ClientID: "linda", Score: 70, Rank: 3},
+				},
+				Total: 6,
+				Next:  encodeCursor(5),
+			},
+		},
+		{
+			name: "test get leaderboard with estimated dense rank case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					RankMode: model.RankDense,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				result := []*model.Score{
+					{ClientID: "adam", Score: 80, Rank: 20001},
+					{ClientID: "linda", Score: 70, Rank: 20002},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), board.Key(), int64(20000), int64(20001), board).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().CountDistinctBetter(gomock.Any(), board.Key(), float64(80), board).Return(int64(15000), true, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(int64(20002), nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board:  "arena",
+					Offset: 20000,
+					Limit:  2,
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{ClientID: "adam", Score: 80, Rank: 15001, Approximate: true},
+					{ClientID: "linda", Score: 70, Rank: 15002, Approximate: true},
+				},
+				Total: 20002,
+			},
+		},
+		{
+			name: "test get leaderboard success case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					start int64 = 0
+					stop  int64 = 9
+					total int64 = 2
+				)
+				result := []*model.Score{
+					{
+						ClientID: "adam",
+						Score:    100,
+						Rank:     1,
+					},
+					{
+						ClientID: "peter",
+						Score:    90,
+						Rank:     2,
+					},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), start, stop, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), []string{"adam", "peter"}).Return(map[string]*model.Profile{
+					"adam": {ClientID: "adam", DisplayName: "Adam", Country: "TW"},
+				}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), testBoard.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{
+						ClientID: "adam",
+						Score:    100,
+						Rank:     1,
+						Profile:  &model.Profile{ClientID: "adam", DisplayName: "Adam", Country: "TW"},
+					},
+					{
+						ClientID: "peter",
+						Score:    90,
+						Rank:     2,
+					},
+				},
+				Total: 2,
+			},
+		},
+		{
+			name: "test get leaderboard with next page case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					start int64 = 2
+					stop  int64 = 3
+					total int64 = 5
+				)
+				result := []*model.Score{
+					{
+						ClientID: "adam",
+						Score:    100,
+						Rank:     3,
+					},
+					{
+						ClientID: "peter",
+						Score:    90,
+						Rank:     4,
+					},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), start, stop, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), testBoard.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board:  model.DefaultBoard,
+					Offset: 2,
+					Limit:  2,
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{
+						ClientID: "adam",
+						Score:    100,
+						Rank:     3,
+					},
+					{
+						ClientID: "peter",
+						Score:    90,
+						Rank:     4,
+					},
+				},
+				Total: 5,
+				Next:  encodeCursor(4),
+			},
+		},
+		{
+			name: "test get leaderboard by cursor case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					start int64 = 4
+					stop  int64 = 5
+					total int64 = 5
+				)
+				result := []*model.Score{
+					{
+						ClientID: "linda",
+						Score:    80,
+						Rank:     5,
+					},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), start, stop, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), testBoard.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+					Limit: 2,
+					Next:  encodeCursor(4),
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{
+						ClientID: "linda",
+						Score:    80,
+						Rank:     5,
+					},
+				},
+				Total: 5,
+			},
+		},
+		{
+			name: "test invalid cursor case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+					Next:  "!",
+				},
+			},
+			wantError: ErrInvalidCursor,
+		},
+		{
+			name: "test page size too large case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				query: &GetLeaderBoard{
+					Board: model.DefaultBoard,
+					Limit: MaxPageSize + 1,
+				},
+			},
+			wantError: ErrInvalidPage,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.GetLeaderBoard(test.args.ctx, test.args.query)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_GetPlayerRank
+func (t *TestSuite) Test_GetPlayerRank() {
+	type args struct {
+		ctx      context.Context
+		board    string
+		window   model.Window
+		segment  string
+		clientID string
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.PlayerRank
+		wantError  error
+	}{
+		{
+			name: "test get player rank success case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					rank  int64 = 1
+					total int64 = 8
+				)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(90.5, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), testBoard.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				clientID: "adam",
+			},
+			wantResult: &model.PlayerRank{
+				ClientID:   "adam",
+				Score:      90.5,
+				Rank:       2,
+				Total:      8,
+				Percentile: 87.5,
+			},
+		},
+		{
+			name: "test get player rank of daily window case",
+			fn: func(in args) {
+				t.usecase.windows = []model.Window{model.WindowDaily}
+
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				bucket, _ := model.WindowDaily.Bucket(time.Now().UTC())
+				key := testBoard.WindowKey(model.WindowDaily, bucket)
+
+				var (
+					rank  int64 = 0
+					total int64 = 2
+				)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), key, in.clientID, testBoard).Return(float64(30), nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), key, in.clientID, testBoard).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), key).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				window:   model.WindowDaily,
+				clientID: "adam",
+			},
+			wantResult: &model.PlayerRank{
+				ClientID:   "adam",
+				Score:      30,
+				Rank:       1,
+				Total:      2,
+				Percentile: 100,
+			},
+		},
+		{
+			name: "test get player rank of segment case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				key := "board:default:segment:country:TW"
+
+				var (
+					rank  int64 = 0
+					total int64 = 4
+				)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), key, in.clientID, testBoard).Return(float64(30), nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), key, in.clientID, testBoard).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), key).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				segment:  "country:TW",
+				clientID: "adam",
+			},
+			wantResult: &model.PlayerRank{
+				ClientID:   "adam",
+				Score:      30,
+				Rank:       1,
+				Total:      4,
+				Percentile: 100,
+			},
+		},
+		{
+			name: "test get player rank of segment not allowed case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				segment:  "country:FR",
+				clientID: "adam",
+			},
+			wantError: ErrInvalidSegment,
+		},
+		{
+			name: "test get player rank of segment in window case",
+			fn: func(in args) {
+				t.usecase.windows = []model.Window{model.WindowDaily}
+
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				window:   model.WindowDaily,
+				segment:  "country:TW",
+				clientID: "adam",
+			},
+			wantError: ErrInvalidWindow,
+		},
+		{
+			name: "test get player rank of window not fanned out case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				window:   model.WindowMonthly,
+				clientID: "adam",
+			},
+			wantError: ErrInvalidWindow,
+		},
+		{
+			name: "test player not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(float64(0), model.ErrPlayerNotFound).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    model.DefaultBoard,
+				clientID: "peter",
+			},
+			wantError: model.ErrPlayerNotFound,
+		},
+		{
+			name: "test get player rank of ascending board case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:    "racing",
+					Order: model.OrderAsc,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				var (
+					rank  int64 = 0
+					total int64 = 4
+				)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), in.clientID, board).Return(61.2, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), board.Key(), in.clientID, board).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    "racing",
+				clientID: "adam",
+			},
+			wantResult: &model.PlayerRank{
+				ClientID:   "adam",
+				Score:      61.2,
+				Rank:       1,
+				Total:      4,
+				Percentile: 100,
+			},
+		},
+		{
+			name: "test get player rank with shared rank case",
+			fn: func(in args) {
+				board := &model.Board{
+					ID:       "arena",
+					RankMode: model.RankShared,
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				var (
+					better int64 = 3
+					total  int64 = 10
+				)
+				t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), board.Key(), in.clientID, board).Return(float64(50), nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().CountBetter(gomock.Any(), board.Key(), float64(50), board).Return(better, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), board.Key()).Return(total, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				board:    "arena",
+				clientID: "adam",
+			},
+			wantResult: &model.PlayerRank{
+				ClientID:   "adam",
+				Score:      50,
+				Rank:       4,
+				Total:      10,
+				Percentile: 70,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.GetPlayerRank(test.args.ctx, &GetPlayer{
+				Board:    test.args.board,
+				Window:   test.args.window,
+				Segment:  test.args.segment,
+				ClientID: test.args.clientID,
+			})
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+
+			t.usecase.windows = nil
+		})
+	}
+}
+
+// Test_GetAroundPlayer
+func (t *TestSuite) Test_GetAroundPlayer() {
+	type args struct {
+		ctx      context.Context
+		clientID string
+		radius   int64
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult []*model.Score
+		wantError  error
 	}{
 		{
-			name: "test ResetLeaderBoard case",
+			name: "test get around player case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					rank   int64 = 5
+					offset int64 = 4
+					limit  int64 = 6
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 5},
+					{ClientID: "peter", Score: 20, Rank: 6},
+					{ClientID: "linda", Score: 10, Rank: 7},
+				}
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), offset, limit, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "peter",
+				radius:   1,
+			},
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 30, Rank: 5},
+				{ClientID: "peter", Score: 20, Rank: 6},
+				{ClientID: "linda", Score: 10, Rank: 7},
+			},
+		},
+		{
+			name: "test get around top player case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				var (
+					rank   int64 = 0
+					offset int64 = 0
+					limit  int64 = 2
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 1},
+					{ClientID: "peter", Score: 20, Rank: 2},
+				}
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(rank, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), testBoard.Key(), offset, limit, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "adam",
+				radius:   2,
+			},
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 30, Rank: 1},
+				{ClientID: "peter", Score: 20, Rank: 2},
+			},
+		},
+		{
+			name: "test player not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), testBoard.Key(), in.clientID, testBoard).Return(int64(0), model.ErrPlayerNotFound).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "john",
+				radius:   5,
+			},
+			wantError: model.ErrPlayerNotFound,
+		},
+		{
+			name: "test invalid radius case",
+			fn:   func(in args) {},
+			args: args{
+				ctx:      context.Background(),
+				clientID: "john",
+				radius:   MaxRadius + 1,
+			},
+			wantError: ErrInvalidRadius,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
+
+			got, err := t.usecase.GetAroundPlayer(test.args.ctx, &GetPlayer{
+				Board:    model.DefaultBoard,
+				ClientID: test.args.clientID,
+				Radius:   test.args.radius,
+			})
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_TeamStandings
+func (t *TestSuite) Test_TeamStandings() {
+	board := &model.Board{
+		ID:       "clans",
+		Order:    model.OrderDesc,
+		Reset:    model.ResetNever,
+		RankMode: model.RankOrdinal,
+		Teams:    &model.Teams{Top: 3},
+	}
+	teams := board.TeamBoard()
+
+	t.Run("test get the page of teams without profiles case", func() {
+		t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), "board:clans:teams", int64(0), int64(9), teams).Return([]*model.Score{
+			{ClientID: "wolves", Score: 300, Rank: 1},
+			{ClientID: "bears", Score: 200, Rank: 2},
+		}, nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), "board:clans:teams").Return(int64(2), nil).Times(1)
+
+		got, err := t.usecase.GetLeaderBoard(context.Background(), &GetLeaderBoard{Board: board.ID, Teams: true})
+		t.NoError(err)
+		t.Equal(&model.ScorePage{
+			Scores: []*model.Score{
+				{ClientID: "wolves", Score: 300, Rank: 1},
+				{ClientID: "bears", Score: 200, Rank: 2},
+			},
+			Total: 2,
+		}, got)
+	})
+
+	t.Run("test get the rank of team case", func() {
+		t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().Score(gomock.Any(), "board:clans:teams", "bears", teams).Return(float64(200), nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), "board:clans:teams", "bears", teams).Return(int64(1), nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().Count(gomock.Any(), "board:clans:teams").Return(int64(2), nil).Times(1)
+
+		got, err := t.usecase.GetPlayerRank(context.Background(), &GetPlayer{Board: board.ID, Teams: true, ClientID: "bears"})
+		t.NoError(err)
+		t.Equal(&model.PlayerRank{ClientID: "bears", Score: 200, Rank: 2, Total: 2, Percentile: 50}, got)
+	})
+
+	t.Run("test get the teams around team case", func() {
+		t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().Rank(gomock.Any(), "board:clans:teams", "bears", teams).Return(int64(1), nil).Times(1)
+		t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), "board:clans:teams", int64(0), int64(2), teams).Return([]*model.Score{
+			{ClientID: "wolves", Score: 300, Rank: 1},
+			{ClientID: "bears", Score: 200, Rank: 2},
+		}, nil).Times(1)
+
+		got, err := t.usecase.GetAroundPlayer(context.Background(), &GetPlayer{Board: board.ID, Teams: true, ClientID: "bears", Radius: 1})
+		t.NoError(err)
+		t.Len(got, 2)
+	})
+
+	t.Run("test board does not rank teams case", func() {
+		t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+		_, err := t.usecase.GetLeaderBoard(context.Background(), &GetLeaderBoard{Board: model.DefaultBoard, Teams: true})
+		t.Equal(ErrNoTeams, err)
+	})
+
+	t.Run("test teams of window case", func() {
+		t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+		_, err := t.usecase.GetPlayerRank(context.Background(), &GetPlayer{Board: board.ID, Teams: true, Window: model.WindowDaily, ClientID: "bears"})
+		t.Equal(ErrInvalidWindow, err)
+	})
+}
+
+// Test_GetFriends
+func (t *TestSuite) Test_GetFriends() {
+	denseBoard := &model.Board{ID: "dense", Order: model.OrderDesc, RankMode: model.RankDense}
+
+	tests := []struct {
+		name       string
+		fn         func()
+		board      string
+		clientID   string
+		wantResult []*model.Score
+		wantError  error
+	}{
+		{
+			name: "test get friends case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetFriends(gomock.Any(), "peter").Return([]string{"adam", "linda"}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Scores(gomock.Any(), testBoard.Key(), []string{"adam", "linda", "peter"}, testBoard).Return([]*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 1},
+					{ClientID: "peter", Score: 20, Rank: 2},
+				}, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), []string{"adam", "peter"}).Return(map[string]*model.Profile{
+					"adam": {ClientID: "adam", DisplayName: "Adam"},
+				}, nil).Times(1)
+			},
+			board:    model.DefaultBoard,
+			clientID: "peter",
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 30, Rank: 1, Profile: &model.Profile{ClientID: "adam", DisplayName: "Adam"}},
+				{ClientID: "peter", Score: 20, Rank: 2},
+			},
+		},
+		{
+			name: "test get friends ranked dense case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "dense").Return(denseBoard, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetFriends(gomock.Any(), "peter").Return([]string{"adam", "linda"}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Scores(gomock.Any(), denseBoard.Key(), []string{"adam", "linda", "peter"}, denseBoard).Return([]*model.Score{
+					{ClientID: "adam", Score: 30, Rank: 1},
+					{ClientID: "linda", Score: 30, Rank: 2},
+					{ClientID: "peter", Score: 20, Rank: 3},
+				}, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), gomock.Any()).Return(map[string]*model.Profile{}, nil).Times(1)
+			},
+			board:    "dense",
+			clientID: "peter",
+			wantResult: []*model.Score{
+				{ClientID: "adam", Score: 30, Rank: 1},
+				{ClientID: "linda", Score: 30, Rank: 1},
+				{ClientID: "peter", Score: 20, Rank: 2},
+			},
+		},
+		{
+			name: "test no friend scored case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetFriends(gomock.Any(), "john").Return([]string{}, nil).Times(1)
+				t.mockLeaderBoardRepository.EXPECT().Scores(gomock.Any(), testBoard.Key(), []string{"john"}, testBoard).Return([]*model.Score{}, nil).Times(1)
+			},
+			board:      model.DefaultBoard,
+			clientID:   "john",
+			wantResult: []*model.Score{},
+		},
+		{
+			name: "test get friends error case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetFriends(gomock.Any(), "john").Return(nil, errors.New("")).Times(1)
+			},
+			board:     model.DefaultBoard,
+			clientID:  "john",
+			wantError: errors.New(""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.GetFriends(context.Background(), &GetPlayer{
+				Board:    test.board,
+				ClientID: test.clientID,
+			})
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_RefreshAggregate
+func (t *TestSuite) Test_RefreshAggregate() {
+	tests := []struct {
+		name       string
+		fn         func()
+		board      string
+		wantResult int64
+		wantError  error
+	}{
+		{
+			name: "test refresh the sum of the last daily buckets case",
+			fn: func() {
+				board := &model.Board{
+					ID: "week",
+					Aggregate: &model.Aggregate{
+						Op:   model.AggregateIntersect,
+						Func: model.AggregateSum,
+						Sources: []*model.Source{
+							{Board: model.DefaultBoard, Window: model.WindowDaily, Last: 3},
+						},
+						TTL: 300,
+					},
+				}
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(1)
+
+				var keys []string
+				for _, bucket := range model.WindowDaily.Buckets(time.Now().UTC(), 3) {
+					keys = append(keys, testBoard.WindowKey(model.WindowDaily, bucket))
+				}
+				t.mockLeaderBoardRepository.EXPECT().Intersect(gomock.Any(), board.Key(), keys, []float64{1, 1, 1}, model.AggregateSum, time.Minute*5).
+					Return(int64(4), nil).Times(1)
+			},
+			board:      "week",
+			wantResult: 4,
+		},
+		{
+			name: "test refresh board not aggregated case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+			},
+			board:     model.DefaultBoard,
+			wantError: ErrNotAggregated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.RefreshAggregate(context.Background(), test.board)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_ListSeasons
+func (t *TestSuite) Test_ListSeasons() {
+	tests := []struct {
+		name       string
+		fn         func()
+		board      string
+		wantResult []*model.Season
+		wantError  error
+	}{
+		{
+			name: "test list seasons success case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockSeasonRepository.EXPECT().ListSeasons(gomock.Any(), testBoard).Return([]*model.Season{
+					{ID: 2, Board: model.DefaultBoard, EndedAt: 1664557200, Total: 5},
+				}, nil).Times(1)
+			},
+			board: model.DefaultBoard,
+			wantResult: []*model.Season{
+				{ID: 2, Board: model.DefaultBoard, EndedAt: 1664557200, Total: 5},
+			},
+		},
+		{
+			name: "test list seasons board not found case",
+			fn: func() {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), "unknown").Return(nil, model.ErrBoardNotFound).Times(1)
+			},
+			board:     "unknown",
+			wantError: model.ErrBoardNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.ListSeasons(context.Background(), test.board)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_GetSeason
+func (t *TestSuite) Test_GetSeason() {
+	type args struct {
+		ctx   context.Context
+		query *GetSeason
+	}
+
+	season := &model.Season{ID: 2, Board: model.DefaultBoard, EndedAt: 1664557200, Total: 3}
+	key := testBoard.SeasonKey(2)
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.ScorePage
+		wantError  error
+	}{
+		{
+			name: "test get season success case",
 			fn: func(in args) {
-				t.mockLeaderBoardRepository.EXPECT().DeleteAll(in.ctx).Return(nil).Times(1)
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockSeasonRepository.EXPECT().GetSeason(gomock.Any(), testBoard, int64(2)).Return(season, nil).Times(1)
+
+				var (
+					start int64 = 0
+					stop  int64 = 1
+				)
+				result := []*model.Score{
+					{ClientID: "adam", Score: 100, Rank: 1},
+					{ClientID: "brian", Score: 90, Rank: 2},
+				}
+				t.mockLeaderBoardRepository.EXPECT().List(gomock.Any(), key, start, stop, testBoard).Return(result, nil).Times(1)
+				t.mockPlayerRepository.EXPECT().GetProfiles(gomock.Any(), []string{"adam", "brian"}).Return(map[string]*model.Profile{}, nil).Times(1)
 			},
 			args: args{
 				ctx: context.Background(),
+				query: &GetSeason{
+					GetLeaderBoard: GetLeaderBoard{
+						Board: model.DefaultBoard,
+						Limit: 2,
+					},
+					Season: 2,
+				},
+			},
+			wantResult: &model.ScorePage{
+				Scores: []*model.Score{
+					{ClientID: "adam", Score: 100, Rank: 1},
+					{ClientID: "brian", Score: 90, Rank: 2},
+				},
+				Total: 3,
+				Next:  encodeCursor(2),
 			},
-			wantError: false,
+		},
+		{
+			name: "test get season not found case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(gomock.Any(), model.DefaultBoard).Return(testBoard, nil).Times(1)
+				t.mockSeasonRepository.EXPECT().GetSeason(gomock.Any(), testBoard, int64(9)).Return(nil, model.ErrSeasonNotFound).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				query: &GetSeason{
+					GetLeaderBoard: GetLeaderBoard{
+						Board: model.DefaultBoard,
+					},
+					Season: 9,
+				},
+			},
+			wantError: model.ErrSeasonNotFound,
+		},
+		{
+			name: "test get season with invalid limit case",
+			fn:   func(in args) {},
+			args: args{
+				ctx: context.Background(),
+				query: &GetSeason{
+					GetLeaderBoard: GetLeaderBoard{
+						Board: model.DefaultBoard,
+						Limit: MaxPageSize + 1,
+					},
+					Season: 2,
+				},
+			},
+			wantError: ErrInvalidPage,
 		},
 	}
 
@@ -328,9 +1898,75 @@ func (t *TestSuite) Test_ResetLeaderBoard() {
 		t.Run(test.name, func() {
 			test.fn(test.args)
 
-			got := t.usecase.ResetLeaderBoard(test.args.ctx)
-			t.Equal(test.wantError, got != nil)
+			got, err := t.usecase.GetSeason(test.args.ctx, test.args.query)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_ResetLeaderBoard
+func (t *TestSuite) Test_ResetLeaderBoard() {
+	type args struct {
+		ctx context.Context
+	}
+
+	tests := []struct {
+		name       string
+		fn         func(args)
+		args       args
+		wantResult *model.ResetResult
+		wantError  bool
+	}{
+		{
+			name: "test ResetLeaderBoard case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(in.ctx, model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().Reset(in.ctx, testBoard, t.usecase.segments, gomock.Any(), int64(10), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, board *model.Board, segments []model.Segment, at time.Time, keep int64, before time.Time) (*model.ResetResult, error) {
+						t.Equal(time.Hour, at.Sub(before))
+						return &model.ResetResult{Board: board.ID, Season: 1, Players: 3, Entries: 2}, nil
+					}).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantResult: &model.ResetResult{Board: model.DefaultBoard, Season: 1, Players: 3, Entries: 2},
+			wantError:  false,
+		},
+		{
+			name: "test ResetLeaderBoard error case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(in.ctx, model.DefaultBoard).Return(testBoard, nil).Times(1)
+
+				t.mockLeaderBoardRepository.EXPECT().Reset(in.ctx, testBoard, gomock.Any(), gomock.Any(), int64(10), gomock.Any()).Return(nil, errors.New("")).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantError: true,
+		},
+		{
+			name: "test ResetLeaderBoard aggregated board case",
+			fn: func(in args) {
+				t.mockBoardRepository.EXPECT().GetBoard(in.ctx, model.DefaultBoard).
+					Return(&model.Board{ID: model.DefaultBoard, Aggregate: &model.Aggregate{}}, nil).Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn(test.args)
 
+			got, err := t.usecase.ResetLeaderBoard(test.args.ctx, model.DefaultBoard)
+			t.Equal(test.wantError, err != nil)
+			t.Equal(test.wantResult, got)
 		})
 	}
 }
@@ -0,0 +1,22 @@
+package signature
+
+// Verify
+type Verify struct {
+	// Game the game id selecting the secret
+	Game string
+
+	// ClientID the client id of request, it is empty when the body carries the client ids
+	ClientID string
+
+	// Timestamp unix seconds the request is signed at
+	Timestamp string
+
+	// Nonce the unique string of request within the replay window
+	Nonce string
+
+	// Signature hex HMAC-SHA256 of the request
+	Signature string
+
+	// Body the raw body of request
+	Body []byte
+}
@@ -0,0 +1,9 @@
+package signature
+
+import "context"
+
+// SignatureUsecase -
+type SignatureUsecase interface {
+	// Verify - check the signature of request by the secret of game, and the request is not replayed
+	Verify(ctx context.Context, command *Verify) error
+}
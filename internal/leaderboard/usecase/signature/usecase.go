@@ -0,0 +1,106 @@
+package signature
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"leaderboard/config"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/domain/repository"
+	"strconv"
+	"time"
+)
+
+const (
+	// MinNonceLength - the nonce is at least 8 characters, so it is hard to guess
+	MinNonceLength = 8
+)
+
+var (
+	// ErrUnknownGame -
+	ErrUnknownGame = errors.New("unknown game")
+
+	// ErrInvalidTimestamp -
+	ErrInvalidTimestamp = errors.New("timestamp is out of the replay window")
+
+	// ErrInvalidNonce -
+	ErrInvalidNonce = errors.New("invalid nonce")
+
+	// ErrInvalidSignature -
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrReplayed -
+	ErrReplayed = errors.New("nonce has been used")
+)
+
+type usecase struct {
+	nonceRepository repository.NonceRepository
+	secrets         map[string]string
+
+	// window the max difference between the timestamp of request and now
+	window time.Duration
+}
+
+// NewUseCase -
+func NewUseCase(nonceRepository repository.NonceRepository, conf config.Config) SignatureUsecase {
+	return &usecase{
+		nonceRepository: nonceRepository,
+		secrets:         conf.Signature.Secrets,
+		window:          conf.Signature.Window,
+	}
+}
+
+// Verify - the request must be signed within the window, and its nonce is recorded only when the signature is valid,
+// so the forged requests can not burn the nonces
+func (u *usecase) Verify(ctx context.Context, command *Verify) error {
+	secret, ok := u.secrets[command.Game]
+	if !ok || secret == "" {
+		return ErrUnknownGame
+	}
+
+	ts, err := strconv.ParseInt(command.Timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if d := time.Since(time.Unix(ts, 0)); d > u.window || d < -u.window {
+		return ErrInvalidTimestamp
+	}
+
+	if !model.ValidKey(command.Nonce, MinNonceLength, model.MaxIDLength) {
+		return ErrInvalidNonce
+	}
+
+	signature, err := hex.DecodeString(command.Signature)
+	if err != nil || !hmac.Equal(signature, sign(secret, command)) {
+		return ErrInvalidSignature
+	}
+
+	// the timestamp is checked within the window on both sides, so the nonce is kept for twice the window
+	saved, err := u.nonceRepository.SaveNonce(ctx, command.Game, command.Nonce, u.window*2)
+	if err != nil {
+		return err
+	}
+
+	if !saved {
+		return ErrReplayed
+	}
+
+	return nil
+}
+
+// Sign - the hex HMAC-SHA256 of request by secret, the game servers sign the same message
+func Sign(secret string, command *Verify) string {
+	return hex.EncodeToString(sign(secret, command))
+}
+
+// sign - HMAC-SHA256 of timestamp, nonce, client id and body joined by newline
+func sign(secret string, command *Verify) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(command.Timestamp + "\n" + command.Nonce + "\n" + command.ClientID + "\n"))
+	mac.Write(command.Body)
+
+	return mac.Sum(nil)
+}
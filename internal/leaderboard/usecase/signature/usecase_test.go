@@ -0,0 +1,143 @@
+package signature
+
+import (
+	"context"
+	"errors"
+	"leaderboard/test/mock/repository"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockNonceRepository *repository.MockNonceRepository
+	usecase             *usecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockNonceRepository = repository.NewMockNonceRepository(t.ctrl)
+
+	t.usecase = &usecase{
+		nonceRepository: t.mockNonceRepository,
+		secrets:         map[string]string{"racing": "s3cret"},
+		window:          time.Minute * 5,
+	}
+}
+
+// TestSignatureUsecase
+func TestSignatureUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// Test_Verify
+func (t *TestSuite) Test_Verify() {
+	signed := func(modify func(*Verify)) *Verify {
+		command := &Verify{
+			Game:      "racing",
+			ClientID:  "adam",
+			Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+			Nonce:     "n-0001-abcd",
+			Body:      []byte(`{"score":100}`),
+		}
+		command.Signature = Sign("s3cret", command)
+		modify(command)
+		return command
+	}
+
+	tests := []struct {
+		name      string
+		fn        func()
+		command   *Verify
+		wantError error
+	}{
+		{
+			name: "test valid signature case",
+			fn: func() {
+				t.mockNonceRepository.EXPECT().SaveNonce(gomock.Any(), "racing", "n-0001-abcd", time.Minute*10).Return(true, nil).Times(1)
+			},
+			command: signed(func(*Verify) {}),
+		},
+		{
+			name: "test replayed request case",
+			fn: func() {
+				t.mockNonceRepository.EXPECT().SaveNonce(gomock.Any(), "racing", "n-0001-abcd", time.Minute*10).Return(false, nil).Times(1)
+			},
+			command:   signed(func(*Verify) {}),
+			wantError: ErrReplayed,
+		},
+		{
+			name: "test save nonce error case",
+			fn: func() {
+				t.mockNonceRepository.EXPECT().SaveNonce(gomock.Any(), "racing", "n-0001-abcd", time.Minute*10).Return(false, errors.New("")).Times(1)
+			},
+			command:   signed(func(*Verify) {}),
+			wantError: errors.New(""),
+		},
+		{
+			name: "test body changed case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.Body = []byte(`{"score":100000}`)
+			}),
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name: "test client changed case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.ClientID = "peter"
+			}),
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name: "test malformed signature case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.Signature = "not-hex"
+			}),
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name: "test timestamp out of window case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.Timestamp = strconv.FormatInt(time.Now().Add(-time.Minute*6).Unix(), 10)
+				v.Signature = Sign("s3cret", v)
+			}),
+			wantError: ErrInvalidTimestamp,
+		},
+		{
+			name: "test invalid nonce case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.Nonce = "n:1"
+			}),
+			wantError: ErrInvalidNonce,
+		},
+		{
+			name: "test unknown game case",
+			fn:   func() {},
+			command: signed(func(v *Verify) {
+				v.Game = "puzzle"
+			}),
+			wantError: ErrUnknownGame,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			err := t.usecase.Verify(context.Background(), test.command)
+			t.Equal(test.wantError, err)
+		})
+	}
+}
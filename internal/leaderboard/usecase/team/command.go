@@ -0,0 +1,19 @@
+package team
+
+// SaveTeam
+type SaveTeam struct {
+	// ID team identifier
+	ID string `json:"-"`
+
+	// Name display name
+	Name string
+}
+
+// Member
+type Member struct {
+	// Team team id
+	Team string
+
+	// ClientID client id of member
+	ClientID string
+}
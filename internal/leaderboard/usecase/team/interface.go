@@ -0,0 +1,21 @@
+package team
+
+import (
+	"context"
+	"leaderboard/internal/leaderboard/domain/model"
+)
+
+// TeamUsecase -
+type TeamUsecase interface {
+	// Save - create team or rename it
+	Save(ctx context.Context, command *SaveTeam) (*model.Team, error)
+
+	// Get - get team with its members
+	Get(ctx context.Context, id string) (*model.Team, error)
+
+	// Join - move player to team
+	Join(ctx context.Context, command *Member) error
+
+	// Leave - remove player from team
+	Leave(ctx context.Context, command *Member) error
+}
@@ -0,0 +1,122 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/internal/leaderboard/domain/repository"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxName - the max characters of team name
+	MaxName = 32
+
+	// MaxMembers - the max members of one team
+	MaxMembers = 100
+)
+
+var (
+	// ErrInvalidTeamID -
+	ErrInvalidTeamID = errors.New("invalid team id")
+
+	// ErrInvalidName -
+	ErrInvalidName = errors.New("invalid team name")
+
+	// ErrInvalidClientID -
+	ErrInvalidClientID = errors.New("invalid client id")
+)
+
+type usecase struct {
+	teamRepository  repository.TeamRepository
+	boardRepository repository.BoardRepository
+}
+
+// NewUseCase -
+func NewUseCase(teamRepository repository.TeamRepository, boardRepository repository.BoardRepository) TeamUsecase {
+	return &usecase{
+		teamRepository:  teamRepository,
+		boardRepository: boardRepository,
+	}
+}
+
+// Save - validate and save the name of team, the members are kept
+func (u *usecase) Save(ctx context.Context, command *SaveTeam) (*model.Team, error) {
+	if !model.ValidID(command.ID) {
+		return nil, ErrInvalidTeamID
+	}
+
+	name := strings.TrimSpace(command.Name)
+	if name == "" || utf8.RuneCountInString(name) > MaxName {
+		return nil, ErrInvalidName
+	}
+
+	if err := u.teamRepository.SaveTeam(ctx, &model.Team{ID: command.ID, Name: name}); err != nil {
+		return nil, err
+	}
+
+	return u.teamRepository.GetTeam(ctx, command.ID)
+}
+
+// Get - get team with its members
+func (u *usecase) Get(ctx context.Context, id string) (*model.Team, error) {
+	return u.teamRepository.GetTeam(ctx, id)
+}
+
+// Join - move player to team, the scores of the previous and the new team are recomputed on every board
+func (u *usecase) Join(ctx context.Context, command *Member) error {
+	if !model.ValidID(command.ClientID) {
+		return ErrInvalidClientID
+	}
+
+	if _, err := u.teamRepository.GetTeam(ctx, command.Team); err != nil {
+		return err
+	}
+
+	previous, err := u.teamRepository.JoinTeam(ctx, command.Team, command.ClientID, MaxMembers)
+	if err != nil {
+		return err
+	}
+
+	if previous == command.Team {
+		return nil
+	}
+
+	return u.refresh(ctx, previous, command.Team)
+}
+
+// Leave - remove player from team, and recompute the scores of team on every board
+func (u *usecase) Leave(ctx context.Context, command *Member) error {
+	if err := u.teamRepository.LeaveTeam(ctx, command.Team, command.ClientID); err != nil {
+		return err
+	}
+
+	return u.refresh(ctx, command.Team)
+}
+
+// refresh - recompute the scores of teams on the boards ranking teams, the empty team id is skipped
+func (u *usecase) refresh(ctx context.Context, teams ...string) error {
+	boards, err := u.boardRepository.ListBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range boards {
+		if !b.TeamsEnabled() {
+			continue
+		}
+
+		for _, team := range teams {
+			if team == "" {
+				continue
+			}
+
+			if err := u.teamRepository.UpdateTeamScore(ctx, b, team); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"leaderboard/internal/leaderboard/domain/model"
+	"leaderboard/test/mock/repository"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TestSuite
+type TestSuite struct {
+	suite.Suite
+	ctrl                *gomock.Controller
+	mockTeamRepository  *repository.MockTeamRepository
+	mockBoardRepository *repository.MockBoardRepository
+	usecase             *usecase
+}
+
+// SetupTest
+func (t *TestSuite) SetupSuite() {
+	t.ctrl = gomock.NewController(t.T())
+	t.mockTeamRepository = repository.NewMockTeamRepository(t.ctrl)
+	t.mockBoardRepository = repository.NewMockBoardRepository(t.ctrl)
+
+	t.usecase = &usecase{
+		teamRepository:  t.mockTeamRepository,
+		boardRepository: t.mockBoardRepository,
+	}
+}
+
+// TestTeamUsecase
+func TestTeamUsecase(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// Test_Save
+func (t *TestSuite) Test_Save() {
+	tests := []struct {
+		name       string
+		fn         func()
+		command    *SaveTeam
+		wantResult *model.Team
+		wantError  error
+	}{
+		{
+			name: "test save team case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().SaveTeam(gomock.Any(), &model.Team{ID: "wolves", Name: "Wolves"}).Return(nil).Times(1)
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "wolves").Return(&model.Team{
+					ID:      "wolves",
+					Name:    "Wolves",
+					Members: []string{"adam"},
+				}, nil).Times(1)
+			},
+			command: &SaveTeam{ID: "wolves", Name: " Wolves "},
+			wantResult: &model.Team{
+				ID:      "wolves",
+				Name:    "Wolves",
+				Members: []string{"adam"},
+			},
+		},
+		{
+			name:      "test invalid team id case",
+			fn:        func() {},
+			command:   &SaveTeam{ID: "team:1", Name: "Wolves"},
+			wantError: ErrInvalidTeamID,
+		},
+		{
+			name:      "test too long name case",
+			fn:        func() {},
+			command:   &SaveTeam{ID: "wolves", Name: strings.Repeat("狼", MaxName+1)},
+			wantError: ErrInvalidName,
+		},
+		{
+			name: "test save team error case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).Return(errors.New("error")).Times(1)
+			},
+			command:   &SaveTeam{ID: "wolves", Name: "Wolves"},
+			wantError: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			got, err := t.usecase.Save(context.Background(), test.command)
+			t.Equal(test.wantError, err)
+			t.Equal(test.wantResult, got)
+		})
+	}
+}
+
+// Test_Join
+func (t *TestSuite) Test_Join() {
+	boards := []*model.Board{
+		{ID: model.DefaultBoard},
+		{ID: "clans", Teams: &model.Teams{Top: 3}},
+	}
+
+	tests := []struct {
+		name      string
+		fn        func()
+		command   *Member
+		wantError error
+	}{
+		{
+			name: "test move to other team case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "wolves").Return(&model.Team{ID: "wolves"}, nil).Times(1)
+				t.mockTeamRepository.EXPECT().JoinTeam(gomock.Any(), "wolves", "adam", int64(MaxMembers)).Return("bears", nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return(boards, nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), boards[1], "bears").Return(nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), boards[1], "wolves").Return(nil).Times(1)
+			},
+			command: &Member{Team: "wolves", ClientID: "adam"},
+		},
+		{
+			name: "test join first team case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "wolves").Return(&model.Team{ID: "wolves"}, nil).Times(1)
+				t.mockTeamRepository.EXPECT().JoinTeam(gomock.Any(), "wolves", "peter", int64(MaxMembers)).Return("", nil).Times(1)
+				t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return(boards, nil).Times(1)
+				t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), boards[1], "wolves").Return(nil).Times(1)
+			},
+			command: &Member{Team: "wolves", ClientID: "peter"},
+		},
+		{
+			name: "test already in team case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "wolves").Return(&model.Team{ID: "wolves"}, nil).Times(1)
+				t.mockTeamRepository.EXPECT().JoinTeam(gomock.Any(), "wolves", "adam", int64(MaxMembers)).Return("wolves", nil).Times(1)
+			},
+			command: &Member{Team: "wolves", ClientID: "adam"},
+		},
+		{
+			name: "test team not found case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "bears").Return(nil, model.ErrTeamNotFound).Times(1)
+			},
+			command:   &Member{Team: "bears", ClientID: "adam"},
+			wantError: model.ErrTeamNotFound,
+		},
+		{
+			name: "test team is full case",
+			fn: func() {
+				t.mockTeamRepository.EXPECT().GetTeam(gomock.Any(), "wolves").Return(&model.Team{ID: "wolves"}, nil).Times(1)
+				t.mockTeamRepository.EXPECT().JoinTeam(gomock.Any(), "wolves", "adam", int64(MaxMembers)).Return("", model.ErrTeamFull).Times(1)
+			},
+			command:   &Member{Team: "wolves", ClientID: "adam"},
+			wantError: model.ErrTeamFull,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.fn()
+
+			t.Equal(test.wantError, t.usecase.Join(context.Background(), test.command))
+		})
+	}
+}
+
+// Test_Leave
+func (t *TestSuite) Test_Leave() {
+	board := &model.Board{ID: "clans", Teams: &model.Teams{}}
+
+	t.mockTeamRepository.EXPECT().LeaveTeam(gomock.Any(), "wolves", "adam").Return(nil).Times(1)
+	t.mockBoardRepository.EXPECT().ListBoards(gomock.Any()).Return([]*model.Board{board}, nil).Times(1)
+	t.mockTeamRepository.EXPECT().UpdateTeamScore(gomock.Any(), board, "wolves").Return(nil).Times(1)
+
+	t.NoError(t.usecase.Leave(context.Background(), &Member{Team: "wolves", ClientID: "adam"}))
+
+	t.mockTeamRepository.EXPECT().LeaveTeam(gomock.Any(), "wolves", "peter").Return(model.ErrNotTeamMember).Times(1)
+
+	t.Equal(model.ErrNotTeamMember, t.usecase.Leave(context.Background(), &Member{Team: "wolves", ClientID: "peter"}))
+}
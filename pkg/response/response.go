@@ -2,6 +2,7 @@ package response
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
@@ -13,9 +14,31 @@ type Response struct {
 // Status -
 type Status struct {
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
 	Time    string `json:"time"`
 }
 
+// CodeError - the error responded with its code, so clients can tell it apart from the others
+type CodeError struct {
+	Code string
+	Err  error
+}
+
+// Error -
+func (e *CodeError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap -
+func (e *CodeError) Unwrap() error {
+	return e.Err
+}
+
+// WithCode -
+func WithCode(code string, err error) error {
+	return &CodeError{Code: code, Err: err}
+}
+
 // Error -
 func Error(ctx context.Context, err error) interface{} {
 	return withStatus(ctx, nil, err)
@@ -29,11 +52,18 @@ func Success(ctx context.Context, data interface{}) interface{} {
 func withStatus(ctx context.Context, data interface{}, e error) interface{} {
 	if e != nil {
 		t := time.Now().In(time.Local)
+		status := Status{
+			Message: e.Error(),
+			Time:    t.Format(time.RFC3339),
+		}
+
+		var coded *CodeError
+		if errors.As(e, &coded) {
+			status.Code = coded.Code
+		}
+
 		return Response{
-			Status: Status{
-				Message: e.Error(),
-				Time:    t.Format(time.RFC3339),
-			},
+			Status: status,
 		}
 	}
 
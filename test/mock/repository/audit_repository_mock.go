@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/audit_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendAudit mocks base method.
+func (m *MockAuditRepository) AppendAudit(ctx context.Context, events []*model.AuditEvent, max int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAudit", ctx, events, max)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendAudit indicates an expected call of AppendAudit.
+func (mr *MockAuditRepositoryMockRecorder) AppendAudit(ctx, events, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAudit", reflect.TypeOf((*MockAuditRepository)(nil).AppendAudit), ctx, events, max)
+}
+
+// ListAudit mocks base method.
+func (m *MockAuditRepository) ListAudit(ctx context.Context, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAudit", ctx, from, to, before, count)
+	ret0, _ := ret[0].([]*model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAudit indicates an expected call of ListAudit.
+func (mr *MockAuditRepositoryMockRecorder) ListAudit(ctx, from, to, before, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAudit", reflect.TypeOf((*MockAuditRepository)(nil).ListAudit), ctx, from, to, before, count)
+}
+
+// ListPlayerAudit mocks base method.
+func (m *MockAuditRepository) ListPlayerAudit(ctx context.Context, clientID string, from, to time.Time, before string, count int64) ([]*model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayerAudit", ctx, clientID, from, to, before, count)
+	ret0, _ := ret[0].([]*model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayerAudit indicates an expected call of ListPlayerAudit.
+func (mr *MockAuditRepositoryMockRecorder) ListPlayerAudit(ctx, clientID, from, to, before, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayerAudit", reflect.TypeOf((*MockAuditRepository)(nil).ListPlayerAudit), ctx, clientID, from, to, before, count)
+}
\ No newline at end of file
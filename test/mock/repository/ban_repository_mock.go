@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/ban_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBanRepository is a mock of BanRepository interface.
+type MockBanRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBanRepositoryMockRecorder
+}
+
+// MockBanRepositoryMockRecorder is the mock recorder for MockBanRepository.
+type MockBanRepositoryMockRecorder struct {
+	mock *MockBanRepository
+}
+
+// NewMockBanRepository creates a new mock instance.
+func NewMockBanRepository(ctrl *gomock.Controller) *MockBanRepository {
+	mock := &MockBanRepository{ctrl: ctrl}
+	mock.recorder = &MockBanRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBanRepository) EXPECT() *MockBanRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Banned mocks base method.
+func (m *MockBanRepository) Banned(ctx context.Context, clientIDs []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Banned", ctx, clientIDs)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Banned indicates an expected call of Banned.
+func (mr *MockBanRepositoryMockRecorder) Banned(ctx, clientIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Banned", reflect.TypeOf((*MockBanRepository)(nil).Banned), ctx, clientIDs)
+}
+
+// DeleteBan mocks base method.
+func (m *MockBanRepository) DeleteBan(ctx context.Context, clientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBan", ctx, clientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBan indicates an expected call of DeleteBan.
+func (mr *MockBanRepositoryMockRecorder) DeleteBan(ctx, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBan", reflect.TypeOf((*MockBanRepository)(nil).DeleteBan), ctx, clientID)
+}
+
+// SaveBan mocks base method.
+func (m *MockBanRepository) SaveBan(ctx context.Context, ban *model.Ban) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBan", ctx, ban)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBan indicates an expected call of SaveBan.
+func (mr *MockBanRepositoryMockRecorder) SaveBan(ctx, ban interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBan", reflect.TypeOf((*MockBanRepository)(nil).SaveBan), ctx, ban)
+}
\ No newline at end of file
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/board_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBoardRepository is a mock of BoardRepository interface.
+type MockBoardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardRepositoryMockRecorder
+}
+
+// MockBoardRepositoryMockRecorder is the mock recorder for MockBoardRepository.
+type MockBoardRepositoryMockRecorder struct {
+	mock *MockBoardRepository
+}
+
+// NewMockBoardRepository creates a new mock instance.
+func NewMockBoardRepository(ctrl *gomock.Controller) *MockBoardRepository {
+	mock := &MockBoardRepository{ctrl: ctrl}
+	mock.recorder = &MockBoardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardRepository) EXPECT() *MockBoardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteBoard mocks base method.
+func (m *MockBoardRepository) DeleteBoard(ctx context.Context, id string, segments []model.Segment, windows []model.Window, at time.Time, keep int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBoard", ctx, id, segments, windows, at, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBoard indicates an expected call of DeleteBoard.
+func (mr *MockBoardRepositoryMockRecorder) DeleteBoard(ctx, id, segments, windows, at, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockBoardRepository)(nil).DeleteBoard), ctx, id, segments, windows, at, keep)
+}
+
+// GetBoard mocks base method.
+func (m *MockBoardRepository) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", ctx, id)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockBoardRepositoryMockRecorder) GetBoard(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardRepository)(nil).GetBoard), ctx, id)
+}
+
+// ListBoards mocks base method.
+func (m *MockBoardRepository) ListBoards(ctx context.Context) ([]*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBoards", ctx)
+	ret0, _ := ret[0].([]*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBoards indicates an expected call of ListBoards.
+func (mr *MockBoardRepositoryMockRecorder) ListBoards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBoards", reflect.TypeOf((*MockBoardRepository)(nil).ListBoards), ctx)
+}
+
+// SaveBoard mocks base method.
+func (m *MockBoardRepository) SaveBoard(ctx context.Context, board *model.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBoard", ctx, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBoard indicates an expected call of SaveBoard.
+func (mr *MockBoardRepositoryMockRecorder) SaveBoard(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBoard", reflect.TypeOf((*MockBoardRepository)(nil).SaveBoard), ctx, board)
+}
\ No newline at end of file
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/entry_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEntryRepository is a mock of EntryRepository interface.
+type MockEntryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEntryRepositoryMockRecorder
+}
+
+// MockEntryRepositoryMockRecorder is the mock recorder for MockEntryRepository.
+type MockEntryRepositoryMockRecorder struct {
+	mock *MockEntryRepository
+}
+
+// NewMockEntryRepository creates a new mock instance.
+func NewMockEntryRepository(ctrl *gomock.Controller) *MockEntryRepository {
+	mock := &MockEntryRepository{ctrl: ctrl}
+	mock.recorder = &MockEntryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEntryRepository) EXPECT() *MockEntryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountEntries mocks base method.
+func (m *MockEntryRepository) CountEntries(ctx context.Context, board *model.Board) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEntries", ctx, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEntries indicates an expected call of CountEntries.
+func (mr *MockEntryRepositoryMockRecorder) CountEntries(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEntries", reflect.TypeOf((*MockEntryRepository)(nil).CountEntries), ctx, board)
+}
+
+// CreateEntry mocks base method.
+func (m *MockEntryRepository) CreateEntry(ctx context.Context, board *model.Board, in *model.Entry, ttl time.Duration) (*model.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, board, in, ttl)
+	ret0, _ := ret[0].(*model.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockEntryRepositoryMockRecorder) CreateEntry(ctx, board, in, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockEntryRepository)(nil).CreateEntry), ctx, board, in, ttl)
+}
+
+// ListEntries mocks base method.
+func (m *MockEntryRepository) ListEntries(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, board, start, stop)
+	ret0, _ := ret[0].([]*model.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockEntryRepositoryMockRecorder) ListEntries(ctx, board, start, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockEntryRepository)(nil).ListEntries), ctx, board, start, stop)
+}
\ No newline at end of file
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/nonce_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNonceRepository is a mock of NonceRepository interface.
+type MockNonceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonceRepositoryMockRecorder
+}
+
+// MockNonceRepositoryMockRecorder is the mock recorder for MockNonceRepository.
+type MockNonceRepositoryMockRecorder struct {
+	mock *MockNonceRepository
+}
+
+// NewMockNonceRepository creates a new mock instance.
+func NewMockNonceRepository(ctrl *gomock.Controller) *MockNonceRepository {
+	mock := &MockNonceRepository{ctrl: ctrl}
+	mock.recorder = &MockNonceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonceRepository) EXPECT() *MockNonceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SaveNonce mocks base method.
+func (m *MockNonceRepository) SaveNonce(ctx context.Context, game, nonce string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNonce", ctx, game, nonce, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveNonce indicates an expected call of SaveNonce.
+func (mr *MockNonceRepositoryMockRecorder) SaveNonce(ctx, game, nonce, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNonce", reflect.TypeOf((*MockNonceRepository)(nil).SaveNonce), ctx, game, nonce, ttl)
+}
\ No newline at end of file
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/player_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPlayerRepository is a mock of PlayerRepository interface.
+type MockPlayerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayerRepositoryMockRecorder
+}
+
+// MockPlayerRepositoryMockRecorder is the mock recorder for MockPlayerRepository.
+type MockPlayerRepositoryMockRecorder struct {
+	mock *MockPlayerRepository
+}
+
+// NewMockPlayerRepository creates a new mock instance.
+func NewMockPlayerRepository(ctrl *gomock.Controller) *MockPlayerRepository {
+	mock := &MockPlayerRepository{ctrl: ctrl}
+	mock.recorder = &MockPlayerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayerRepository) EXPECT() *MockPlayerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetFriends mocks base method.
+func (m *MockPlayerRepository) GetFriends(ctx context.Context, clientID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriends", ctx, clientID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriends indicates an expected call of GetFriends.
+func (mr *MockPlayerRepositoryMockRecorder) GetFriends(ctx, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockPlayerRepository)(nil).GetFriends), ctx, clientID)
+}
+
+// GetProfile mocks base method.
+func (m *MockPlayerRepository) GetProfile(ctx context.Context, clientID string) (*model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, clientID)
+	ret0, _ := ret[0].(*model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockPlayerRepositoryMockRecorder) GetProfile(ctx, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockPlayerRepository)(nil).GetProfile), ctx, clientID)
+}
+
+// GetProfiles mocks base method.
+func (m *MockPlayerRepository) GetProfiles(ctx context.Context, clientIDs []string) (map[string]*model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfiles", ctx, clientIDs)
+	ret0, _ := ret[0].(map[string]*model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfiles indicates an expected call of GetProfiles.
+func (mr *MockPlayerRepositoryMockRecorder) GetProfiles(ctx, clientIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfiles", reflect.TypeOf((*MockPlayerRepository)(nil).GetProfiles), ctx, clientIDs)
+}
+
+// SaveFriends mocks base method.
+func (m *MockPlayerRepository) SaveFriends(ctx context.Context, clientID string, friends []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFriends", ctx, clientID, friends)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFriends indicates an expected call of SaveFriends.
+func (mr *MockPlayerRepositoryMockRecorder) SaveFriends(ctx, clientID, friends interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFriends", reflect.TypeOf((*MockPlayerRepository)(nil).SaveFriends), ctx, clientID, friends)
+}
+
+// SaveProfile mocks base method.
+func (m *MockPlayerRepository) SaveProfile(ctx context.Context, profile *model.Profile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProfile", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProfile indicates an expected call of SaveProfile.
+func (mr *MockPlayerRepositoryMockRecorder) SaveProfile(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProfile", reflect.TypeOf((*MockPlayerRepository)(nil).SaveProfile), ctx, profile)
+}
\ No newline at end of file
@@ -36,32 +36,80 @@ func (m *MockLeaderBoardRepository) EXPECT() *MockLeaderBoardRepositoryMockRecor
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockLeaderBoardRepository) Count(ctx context.Context, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Count(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Count), ctx, key)
+}
+
+// CountBetter mocks base method.
+func (m *MockLeaderBoardRepository) CountBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBetter", ctx, key, score, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBetter indicates an expected call of CountBetter.
+func (mr *MockLeaderBoardRepositoryMockRecorder) CountBetter(ctx, key, score, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBetter", reflect.TypeOf((*MockLeaderBoardRepository)(nil).CountBetter), ctx, key, score, board)
+}
+
+// CountDistinctBetter mocks base method.
+func (m *MockLeaderBoardRepository) CountDistinctBetter(ctx context.Context, key string, score float64, board *model.Board) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDistinctBetter", ctx, key, score, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountDistinctBetter indicates an expected call of CountDistinctBetter.
+func (mr *MockLeaderBoardRepositoryMockRecorder) CountDistinctBetter(ctx, key, score, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDistinctBetter", reflect.TypeOf((*MockLeaderBoardRepository)(nil).CountDistinctBetter), ctx, key, score, board)
+}
+
 // Create mocks base method.
-func (m *MockLeaderBoardRepository) Create(ctx context.Context, key string, score *model.Score) error {
+func (m *MockLeaderBoardRepository) Create(ctx context.Context, key string, score *model.Score, board *model.Board) (*model.ScoreResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, key, score)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Create", ctx, key, score, board)
+	ret0, _ := ret[0].(*model.ScoreResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockLeaderBoardRepositoryMockRecorder) Create(ctx, key, score interface{}) *gomock.Call {
+func (mr *MockLeaderBoardRepositoryMockRecorder) Create(ctx, key, score, board interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Create), ctx, key, score)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Create), ctx, key, score, board)
 }
 
-// DeleteAll mocks base method.
-func (m *MockLeaderBoardRepository) DeleteAll(ctx context.Context) error {
+// CreateBatch mocks base method.
+func (m *MockLeaderBoardRepository) CreateBatch(ctx context.Context, key string, scores []*model.Score, board *model.Board) ([]*model.ScoreResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAll", ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, key, scores, board)
+	ret0, _ := ret[0].([]*model.ScoreResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAll indicates an expected call of DeleteAll.
-func (mr *MockLeaderBoardRepositoryMockRecorder) DeleteAll(ctx interface{}) *gomock.Call {
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockLeaderBoardRepositoryMockRecorder) CreateBatch(ctx, key, scores, board interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockLeaderBoardRepository)(nil).DeleteAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockLeaderBoardRepository)(nil).CreateBatch), ctx, key, scores, board)
 }
 
 // Exists mocks base method.
@@ -78,19 +126,108 @@ func (mr *MockLeaderBoardRepositoryMockRecorder) Exists(ctx, key interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Exists), ctx, key)
 }
 
+// Intersect mocks base method.
+func (m *MockLeaderBoardRepository) Intersect(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Intersect", ctx, key, sources, weights, aggregate, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Intersect indicates an expected call of Intersect.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Intersect(ctx, key, sources, weights, aggregate, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Intersect", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Intersect), ctx, key, sources, weights, aggregate, ttl)
+}
+
 // List mocks base method.
-func (m *MockLeaderBoardRepository) List(ctx context.Context, key string, offset, limit int64) ([]*model.Score, error) {
+func (m *MockLeaderBoardRepository) List(ctx context.Context, key string, start, stop int64, board *model.Board) ([]*model.Score, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, key, offset, limit)
+	ret := m.ctrl.Call(m, "List", ctx, key, start, stop, board)
 	ret0, _ := ret[0].([]*model.Score)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockLeaderBoardRepositoryMockRecorder) List(ctx, key, offset, limit interface{}) *gomock.Call {
+func (mr *MockLeaderBoardRepositoryMockRecorder) List(ctx, key, start, stop, board interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLeaderBoardRepository)(nil).List), ctx, key, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLeaderBoardRepository)(nil).List), ctx, key, start, stop, board)
+}
+
+// Rank mocks base method.
+func (m *MockLeaderBoardRepository) Rank(ctx context.Context, key, member string, board *model.Board) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rank", ctx, key, member, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rank indicates an expected call of Rank.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Rank(ctx, key, member, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rank", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Rank), ctx, key, member, board)
+}
+
+// Remove mocks base method.
+func (m *MockLeaderBoardRepository) Remove(ctx context.Context, keys []string, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, keys, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Remove(ctx, keys, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Remove), ctx, keys, member)
+}
+
+// Reset mocks base method.
+func (m *MockLeaderBoardRepository) Reset(ctx context.Context, board *model.Board, segments []model.Segment, at time.Time, keep int64, before time.Time) (*model.ResetResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, board, segments, at, keep, before)
+	ret0, _ := ret[0].(*model.ResetResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Reset(ctx, board, segments, at, keep, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Reset), ctx, board, segments, at, keep, before)
+}
+
+// Score mocks base method.
+func (m *MockLeaderBoardRepository) Score(ctx context.Context, key, member string, board *model.Board) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Score", ctx, key, member, board)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Score indicates an expected call of Score.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Score(ctx, key, member, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Score", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Score), ctx, key, member, board)
+}
+
+// Scores mocks base method.
+func (m *MockLeaderBoardRepository) Scores(ctx context.Context, key string, members []string, board *model.Board) ([]*model.Score, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scores", ctx, key, members, board)
+	ret0, _ := ret[0].([]*model.Score)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scores indicates an expected call of Scores.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Scores(ctx, key, members, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scores", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Scores), ctx, key, members, board)
 }
 
 // SetExpire mocks base method.
@@ -105,4 +242,19 @@ func (m *MockLeaderBoardRepository) SetExpire(ctx context.Context, key string, t
 func (mr *MockLeaderBoardRepositoryMockRecorder) SetExpire(ctx, key, t interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExpire", reflect.TypeOf((*MockLeaderBoardRepository)(nil).SetExpire), ctx, key, t)
+}
+
+// Union mocks base method.
+func (m *MockLeaderBoardRepository) Union(ctx context.Context, key string, sources []string, weights []float64, aggregate model.AggregateFunc, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Union", ctx, key, sources, weights, aggregate, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Union indicates an expected call of Union.
+func (mr *MockLeaderBoardRepositoryMockRecorder) Union(ctx, key, sources, weights, aggregate, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Union", reflect.TypeOf((*MockLeaderBoardRepository)(nil).Union), ctx, key, sources, weights, aggregate, ttl)
 }
\ No newline at end of file
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/rule_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRuleRepository is a mock of RuleRepository interface.
+type MockRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRuleRepositoryMockRecorder
+}
+
+// MockRuleRepositoryMockRecorder is the mock recorder for MockRuleRepository.
+type MockRuleRepositoryMockRecorder struct {
+	mock *MockRuleRepository
+}
+
+// NewMockRuleRepository creates a new mock instance.
+func NewMockRuleRepository(ctrl *gomock.Controller) *MockRuleRepository {
+	mock := &MockRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRuleRepository) EXPECT() *MockRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountQuarantine mocks base method.
+func (m *MockRuleRepository) CountQuarantine(ctx context.Context, board *model.Board) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountQuarantine", ctx, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountQuarantine indicates an expected call of CountQuarantine.
+func (mr *MockRuleRepositoryMockRecorder) CountQuarantine(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountQuarantine", reflect.TypeOf((*MockRuleRepository)(nil).CountQuarantine), ctx, board)
+}
+
+// CountSubmission mocks base method.
+func (m *MockRuleRepository) CountSubmission(ctx context.Context, board *model.Board, clientID string, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSubmission", ctx, board, clientID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSubmission indicates an expected call of CountSubmission.
+func (mr *MockRuleRepositoryMockRecorder) CountSubmission(ctx, board, clientID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSubmission", reflect.TypeOf((*MockRuleRepository)(nil).CountSubmission), ctx, board, clientID, at)
+}
+
+// ListQuarantine mocks base method.
+func (m *MockRuleRepository) ListQuarantine(ctx context.Context, board *model.Board, start, stop int64) ([]*model.Quarantined, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuarantine", ctx, board, start, stop)
+	ret0, _ := ret[0].([]*model.Quarantined)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantine indicates an expected call of ListQuarantine.
+func (mr *MockRuleRepositoryMockRecorder) ListQuarantine(ctx, board, start, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantine", reflect.TypeOf((*MockRuleRepository)(nil).ListQuarantine), ctx, board, start, stop)
+}
+
+// Quarantine mocks base method.
+func (m *MockRuleRepository) Quarantine(ctx context.Context, board *model.Board, submission *model.Quarantined, max int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quarantine", ctx, board, submission, max)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Quarantine indicates an expected call of Quarantine.
+func (mr *MockRuleRepositoryMockRecorder) Quarantine(ctx, board, submission, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quarantine", reflect.TypeOf((*MockRuleRepository)(nil).Quarantine), ctx, board, submission, max)
+}
\ No newline at end of file
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/season_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSeasonRepository is a mock of SeasonRepository interface.
+type MockSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonRepositoryMockRecorder
+}
+
+// MockSeasonRepositoryMockRecorder is the mock recorder for MockSeasonRepository.
+type MockSeasonRepositoryMockRecorder struct {
+	mock *MockSeasonRepository
+}
+
+// NewMockSeasonRepository creates a new mock instance.
+func NewMockSeasonRepository(ctrl *gomock.Controller) *MockSeasonRepository {
+	mock := &MockSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonRepository) EXPECT() *MockSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSeason mocks base method.
+func (m *MockSeasonRepository) GetSeason(ctx context.Context, board *model.Board, id int64) (*model.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeason", ctx, board, id)
+	ret0, _ := ret[0].(*model.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeason indicates an expected call of GetSeason.
+func (mr *MockSeasonRepositoryMockRecorder) GetSeason(ctx, board, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeason", reflect.TypeOf((*MockSeasonRepository)(nil).GetSeason), ctx, board, id)
+}
+
+// ListSeasons mocks base method.
+func (m *MockSeasonRepository) ListSeasons(ctx context.Context, board *model.Board) ([]*model.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasons", ctx, board)
+	ret0, _ := ret[0].([]*model.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasons indicates an expected call of ListSeasons.
+func (mr *MockSeasonRepositoryMockRecorder) ListSeasons(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasons", reflect.TypeOf((*MockSeasonRepository)(nil).ListSeasons), ctx, board)
+}
\ No newline at end of file
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/domain/repository/team_repository.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTeamRepository is a mock of TeamRepository interface.
+type MockTeamRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamRepositoryMockRecorder
+}
+
+// MockTeamRepositoryMockRecorder is the mock recorder for MockTeamRepository.
+type MockTeamRepositoryMockRecorder struct {
+	mock *MockTeamRepository
+}
+
+// NewMockTeamRepository creates a new mock instance.
+func NewMockTeamRepository(ctrl *gomock.Controller) *MockTeamRepository {
+	mock := &MockTeamRepository{ctrl: ctrl}
+	mock.recorder = &MockTeamRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPlayerTeam mocks base method.
+func (m *MockTeamRepository) GetPlayerTeam(ctx context.Context, clientID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerTeam", ctx, clientID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerTeam indicates an expected call of GetPlayerTeam.
+func (mr *MockTeamRepositoryMockRecorder) GetPlayerTeam(ctx, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerTeam", reflect.TypeOf((*MockTeamRepository)(nil).GetPlayerTeam), ctx, clientID)
+}
+
+// GetTeam mocks base method.
+func (m *MockTeamRepository) GetTeam(ctx context.Context, id string) (*model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeam", ctx, id)
+	ret0, _ := ret[0].(*model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockTeamRepositoryMockRecorder) GetTeam(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockTeamRepository)(nil).GetTeam), ctx, id)
+}
+
+// JoinTeam mocks base method.
+func (m *MockTeamRepository) JoinTeam(ctx context.Context, team, clientID string, max int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinTeam", ctx, team, clientID, max)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinTeam indicates an expected call of JoinTeam.
+func (mr *MockTeamRepositoryMockRecorder) JoinTeam(ctx, team, clientID, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinTeam", reflect.TypeOf((*MockTeamRepository)(nil).JoinTeam), ctx, team, clientID, max)
+}
+
+// LeaveTeam mocks base method.
+func (m *MockTeamRepository) LeaveTeam(ctx context.Context, team, clientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveTeam", ctx, team, clientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveTeam indicates an expected call of LeaveTeam.
+func (mr *MockTeamRepositoryMockRecorder) LeaveTeam(ctx, team, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveTeam", reflect.TypeOf((*MockTeamRepository)(nil).LeaveTeam), ctx, team, clientID)
+}
+
+// SaveTeam mocks base method.
+func (m *MockTeamRepository) SaveTeam(ctx context.Context, team *model.Team) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTeam", ctx, team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTeam indicates an expected call of SaveTeam.
+func (mr *MockTeamRepositoryMockRecorder) SaveTeam(ctx, team interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeam", reflect.TypeOf((*MockTeamRepository)(nil).SaveTeam), ctx, team)
+}
+
+// UpdateTeamScore mocks base method.
+func (m *MockTeamRepository) UpdateTeamScore(ctx context.Context, board *model.Board, team string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamScore", ctx, board, team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamScore indicates an expected call of UpdateTeamScore.
+func (mr *MockTeamRepositoryMockRecorder) UpdateTeamScore(ctx, board, team interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamScore", reflect.TypeOf((*MockTeamRepository)(nil).UpdateTeamScore), ctx, board, team)
+}
\ No newline at end of file
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/usecase/board/interface.go
+
+// Package socre is a generated GoMock package.
+package socre
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	board "leaderboard/internal/leaderboard/usecase/board"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBoardUsecase is a mock of BoardUsecase interface.
+type MockBoardUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardUsecaseMockRecorder
+}
+
+// MockBoardUsecaseMockRecorder is the mock recorder for MockBoardUsecase.
+type MockBoardUsecaseMockRecorder struct {
+	mock *MockBoardUsecase
+}
+
+// NewMockBoardUsecase creates a new mock instance.
+func NewMockBoardUsecase(ctrl *gomock.Controller) *MockBoardUsecase {
+	mock := &MockBoardUsecase{ctrl: ctrl}
+	mock.recorder = &MockBoardUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardUsecase) EXPECT() *MockBoardUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBoardUsecase) Create(ctx context.Context, command *board.CreateBoard) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, command)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardUsecaseMockRecorder) Create(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardUsecase)(nil).Create), ctx, command)
+}
+
+// Delete mocks base method.
+func (m *MockBoardUsecase) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardUsecaseMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardUsecase)(nil).Delete), ctx, id)
+}
+
+// Init mocks base method.
+func (m *MockBoardUsecase) Init(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockBoardUsecaseMockRecorder) Init(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockBoardUsecase)(nil).Init), ctx)
+}
+
+// List mocks base method.
+func (m *MockBoardUsecase) List(ctx context.Context) ([]*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockBoardUsecaseMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBoardUsecase)(nil).List), ctx)
+}
\ No newline at end of file
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/usecase/idempotency/interface.go
+
+// Package socre is a generated GoMock package.
+package socre
+
+import (
+	context "context"
+	json "encoding/json"
+	idempotency "leaderboard/internal/leaderboard/usecase/idempotency"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIdempotencyUsecase is a mock of IdempotencyUsecase interface.
+type MockIdempotencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUsecaseMockRecorder
+}
+
+// MockIdempotencyUsecaseMockRecorder is the mock recorder for MockIdempotencyUsecase.
+type MockIdempotencyUsecaseMockRecorder struct {
+	mock *MockIdempotencyUsecase
+}
+
+// NewMockIdempotencyUsecase creates a new mock instance.
+func NewMockIdempotencyUsecase(ctrl *gomock.Controller) *MockIdempotencyUsecase {
+	mock := &MockIdempotencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUsecase) EXPECT() *MockIdempotencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Abort mocks base method.
+func (m *MockIdempotencyUsecase) Abort(ctx context.Context, command *idempotency.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Abort", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort.
+func (mr *MockIdempotencyUsecaseMockRecorder) Abort(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Abort), ctx, command)
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyUsecase) Begin(ctx context.Context, command *idempotency.Request) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, command)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyUsecaseMockRecorder) Begin(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Begin), ctx, command)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUsecase) Complete(ctx context.Context, command *idempotency.Request, result interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, command, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUsecaseMockRecorder) Complete(ctx, command, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Complete), ctx, command, result)
+}
+
+// Fail mocks base method.
+func (m *MockIdempotencyUsecase) Fail(ctx context.Context, command *idempotency.Request, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", ctx, command, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockIdempotencyUsecaseMockRecorder) Fail(ctx, command, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Fail), ctx, command, err)
+}
\ No newline at end of file
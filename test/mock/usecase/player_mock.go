@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/usecase/player/interface.go
+
+// Package socre is a generated GoMock package.
+package socre
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	player "leaderboard/internal/leaderboard/usecase/player"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPlayerUsecase is a mock of PlayerUsecase interface.
+type MockPlayerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayerUsecaseMockRecorder
+}
+
+// MockPlayerUsecaseMockRecorder is the mock recorder for MockPlayerUsecase.
+type MockPlayerUsecaseMockRecorder struct {
+	mock *MockPlayerUsecase
+}
+
+// NewMockPlayerUsecase creates a new mock instance.
+func NewMockPlayerUsecase(ctrl *gomock.Controller) *MockPlayerUsecase {
+	mock := &MockPlayerUsecase{ctrl: ctrl}
+	mock.recorder = &MockPlayerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayerUsecase) EXPECT() *MockPlayerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetProfile mocks base method.
+func (m *MockPlayerUsecase) GetProfile(ctx context.Context, clientID string) (*model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, clientID)
+	ret0, _ := ret[0].(*model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockPlayerUsecaseMockRecorder) GetProfile(ctx, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockPlayerUsecase)(nil).GetProfile), ctx, clientID)
+}
+
+// SaveFriends mocks base method.
+func (m *MockPlayerUsecase) SaveFriends(ctx context.Context, command *player.SaveFriends) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFriends", ctx, command)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFriends indicates an expected call of SaveFriends.
+func (mr *MockPlayerUsecaseMockRecorder) SaveFriends(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFriends", reflect.TypeOf((*MockPlayerUsecase)(nil).SaveFriends), ctx, command)
+}
+
+// SaveProfile mocks base method.
+func (m *MockPlayerUsecase) SaveProfile(ctx context.Context, command *player.SaveProfile) (*model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProfile", ctx, command)
+	ret0, _ := ret[0].(*model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProfile indicates an expected call of SaveProfile.
+func (mr *MockPlayerUsecaseMockRecorder) SaveProfile(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProfile", reflect.TypeOf((*MockPlayerUsecase)(nil).SaveProfile), ctx, command)
+}
\ No newline at end of file
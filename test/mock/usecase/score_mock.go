@@ -37,11 +37,12 @@ func (m *MockScoreUsecase) EXPECT() *MockScoreUsecaseMockRecorder {
 }
 
 // Add mocks base method.
-func (m *MockScoreUsecase) Add(ctx context.Context, command *score.AddScore) error {
+func (m *MockScoreUsecase) Add(ctx context.Context, command *score.AddScore) (*model.ScoreResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Add", ctx, command)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.ScoreResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Add indicates an expected call of Add.
@@ -50,12 +51,28 @@ func (mr *MockScoreUsecaseMockRecorder) Add(ctx, command interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockScoreUsecase)(nil).Add), ctx, command)
 }
 
+// AddBatch mocks base method.
+func (m *MockScoreUsecase) AddBatch(ctx context.Context, command *score.AddScores) ([]*model.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBatch", ctx, command)
+	ret0, _ := ret[0].([]*model.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBatch indicates an expected call of AddBatch.
+func (mr *MockScoreUsecaseMockRecorder) AddBatch(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBatch", reflect.TypeOf((*MockScoreUsecase)(nil).AddBatch), ctx, command)
+}
+
 // AddIgnoreDuplicate mocks base method.
-func (m *MockScoreUsecase) AddIgnoreDuplicate(ctx context.Context, command *score.AddScore) error {
+func (m *MockScoreUsecase) AddIgnoreDuplicate(ctx context.Context, command *score.AddScore) (*model.Entry, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddIgnoreDuplicate", ctx, command)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddIgnoreDuplicate indicates an expected call of AddIgnoreDuplicate.
@@ -64,31 +81,225 @@ func (mr *MockScoreUsecaseMockRecorder) AddIgnoreDuplicate(ctx, command interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIgnoreDuplicate", reflect.TypeOf((*MockScoreUsecase)(nil).AddIgnoreDuplicate), ctx, command)
 }
 
-// GetLeaderBoard mocks base method.
-func (m *MockScoreUsecase) GetLeaderBoard(ctx context.Context) ([]*model.Score, error) {
+// Ban mocks base method.
+func (m *MockScoreUsecase) Ban(ctx context.Context, command *score.BanPlayer) (*model.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ban", ctx, command)
+	ret0, _ := ret[0].(*model.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ban indicates an expected call of Ban.
+func (mr *MockScoreUsecaseMockRecorder) Ban(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ban", reflect.TypeOf((*MockScoreUsecase)(nil).Ban), ctx, command)
+}
+
+// GetAroundPlayer mocks base method.
+func (m *MockScoreUsecase) GetAroundPlayer(ctx context.Context, query *score.GetPlayer) ([]*model.Score, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLeaderBoard", ctx)
+	ret := m.ctrl.Call(m, "GetAroundPlayer", ctx, query)
 	ret0, _ := ret[0].([]*model.Score)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetAroundPlayer indicates an expected call of GetAroundPlayer.
+func (mr *MockScoreUsecaseMockRecorder) GetAroundPlayer(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAroundPlayer", reflect.TypeOf((*MockScoreUsecase)(nil).GetAroundPlayer), ctx, query)
+}
+
+// GetAudit mocks base method.
+func (m *MockScoreUsecase) GetAudit(ctx context.Context, query *score.GetAudit) (*model.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAudit", ctx, query)
+	ret0, _ := ret[0].(*model.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAudit indicates an expected call of GetAudit.
+func (mr *MockScoreUsecaseMockRecorder) GetAudit(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAudit", reflect.TypeOf((*MockScoreUsecase)(nil).GetAudit), ctx, query)
+}
+
+// GetEntries mocks base method.
+func (m *MockScoreUsecase) GetEntries(ctx context.Context, query *score.GetLeaderBoard) (*model.EntryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", ctx, query)
+	ret0, _ := ret[0].(*model.EntryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockScoreUsecaseMockRecorder) GetEntries(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockScoreUsecase)(nil).GetEntries), ctx, query)
+}
+
+// GetFriends mocks base method.
+func (m *MockScoreUsecase) GetFriends(ctx context.Context, query *score.GetPlayer) ([]*model.Score, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriends", ctx, query)
+	ret0, _ := ret[0].([]*model.Score)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriends indicates an expected call of GetFriends.
+func (mr *MockScoreUsecaseMockRecorder) GetFriends(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockScoreUsecase)(nil).GetFriends), ctx, query)
+}
+
+// GetLeaderBoard mocks base method.
+func (m *MockScoreUsecase) GetLeaderBoard(ctx context.Context, query *score.GetLeaderBoard) (*model.ScorePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderBoard", ctx, query)
+	ret0, _ := ret[0].(*model.ScorePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetLeaderBoard indicates an expected call of GetLeaderBoard.
-func (mr *MockScoreUsecaseMockRecorder) GetLeaderBoard(ctx interface{}) *gomock.Call {
+func (mr *MockScoreUsecaseMockRecorder) GetLeaderBoard(ctx, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderBoard", reflect.TypeOf((*MockScoreUsecase)(nil).GetLeaderBoard), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderBoard", reflect.TypeOf((*MockScoreUsecase)(nil).GetLeaderBoard), ctx, query)
 }
 
-// ResetLeaderBoard mocks base method.
-func (m *MockScoreUsecase) ResetLeaderBoard(ctx context.Context) error {
+// GetPlayerRank mocks base method.
+func (m *MockScoreUsecase) GetPlayerRank(ctx context.Context, query *score.GetPlayer) (*model.PlayerRank, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetLeaderBoard", ctx)
+	ret := m.ctrl.Call(m, "GetPlayerRank", ctx, query)
+	ret0, _ := ret[0].(*model.PlayerRank)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerRank indicates an expected call of GetPlayerRank.
+func (mr *MockScoreUsecaseMockRecorder) GetPlayerRank(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerRank", reflect.TypeOf((*MockScoreUsecase)(nil).GetPlayerRank), ctx, query)
+}
+
+// GetQuarantine mocks base method.
+func (m *MockScoreUsecase) GetQuarantine(ctx context.Context, query *score.GetLeaderBoard) (*model.QuarantinePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuarantine", ctx, query)
+	ret0, _ := ret[0].(*model.QuarantinePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuarantine indicates an expected call of GetQuarantine.
+func (mr *MockScoreUsecaseMockRecorder) GetQuarantine(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarantine", reflect.TypeOf((*MockScoreUsecase)(nil).GetQuarantine), ctx, query)
+}
+
+// GetSeason mocks base method.
+func (m *MockScoreUsecase) GetSeason(ctx context.Context, query *score.GetSeason) (*model.ScorePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeason", ctx, query)
+	ret0, _ := ret[0].(*model.ScorePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeason indicates an expected call of GetSeason.
+func (mr *MockScoreUsecaseMockRecorder) GetSeason(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeason", reflect.TypeOf((*MockScoreUsecase)(nil).GetSeason), ctx, query)
+}
+
+// ListSeasons mocks base method.
+func (m *MockScoreUsecase) ListSeasons(ctx context.Context, board string) ([]*model.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasons", ctx, board)
+	ret0, _ := ret[0].([]*model.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasons indicates an expected call of ListSeasons.
+func (mr *MockScoreUsecaseMockRecorder) ListSeasons(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasons", reflect.TypeOf((*MockScoreUsecase)(nil).ListSeasons), ctx, board)
+}
+
+// RefreshAggregate mocks base method.
+func (m *MockScoreUsecase) RefreshAggregate(ctx context.Context, board string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshAggregate", ctx, board)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshAggregate indicates an expected call of RefreshAggregate.
+func (mr *MockScoreUsecaseMockRecorder) RefreshAggregate(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshAggregate", reflect.TypeOf((*MockScoreUsecase)(nil).RefreshAggregate), ctx, board)
+}
+
+// RemovePlayer mocks base method.
+func (m *MockScoreUsecase) RemovePlayer(ctx context.Context, command *score.RemovePlayer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlayer", ctx, command)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RemovePlayer indicates an expected call of RemovePlayer.
+func (mr *MockScoreUsecaseMockRecorder) RemovePlayer(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlayer", reflect.TypeOf((*MockScoreUsecase)(nil).RemovePlayer), ctx, command)
+}
+
+// ResetLeaderBoard mocks base method.
+func (m *MockScoreUsecase) ResetLeaderBoard(ctx context.Context, board string) (*model.ResetResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLeaderBoard", ctx, board)
+	ret0, _ := ret[0].(*model.ResetResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // ResetLeaderBoard indicates an expected call of ResetLeaderBoard.
-func (mr *MockScoreUsecaseMockRecorder) ResetLeaderBoard(ctx interface{}) *gomock.Call {
+func (mr *MockScoreUsecaseMockRecorder) ResetLeaderBoard(ctx, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLeaderBoard", reflect.TypeOf((*MockScoreUsecase)(nil).ResetLeaderBoard), ctx, board)
+}
+
+// SetScore mocks base method.
+func (m *MockScoreUsecase) SetScore(ctx context.Context, command *score.SetScore) (*model.ScoreResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScore", ctx, command)
+	ret0, _ := ret[0].(*model.ScoreResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScore indicates an expected call of SetScore.
+func (mr *MockScoreUsecaseMockRecorder) SetScore(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScore", reflect.TypeOf((*MockScoreUsecase)(nil).SetScore), ctx, command)
+}
+
+// Unban mocks base method.
+func (m *MockScoreUsecase) Unban(ctx context.Context, command *score.BanPlayer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unban", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unban indicates an expected call of Unban.
+func (mr *MockScoreUsecaseMockRecorder) Unban(ctx, command interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLeaderBoard", reflect.TypeOf((*MockScoreUsecase)(nil).ResetLeaderBoard), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unban", reflect.TypeOf((*MockScoreUsecase)(nil).Unban), ctx, command)
 }
\ No newline at end of file
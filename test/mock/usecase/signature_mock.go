@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/usecase/signature/interface.go
+
+// Package socre is a generated GoMock package.
+package socre
+
+import (
+	context "context"
+	signature "leaderboard/internal/leaderboard/usecase/signature"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSignatureUsecase is a mock of SignatureUsecase interface.
+type MockSignatureUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureUsecaseMockRecorder
+}
+
+// MockSignatureUsecaseMockRecorder is the mock recorder for MockSignatureUsecase.
+type MockSignatureUsecaseMockRecorder struct {
+	mock *MockSignatureUsecase
+}
+
+// NewMockSignatureUsecase creates a new mock instance.
+func NewMockSignatureUsecase(ctrl *gomock.Controller) *MockSignatureUsecase {
+	mock := &MockSignatureUsecase{ctrl: ctrl}
+	mock.recorder = &MockSignatureUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureUsecase) EXPECT() *MockSignatureUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockSignatureUsecase) Verify(ctx context.Context, command *signature.Verify) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockSignatureUsecaseMockRecorder) Verify(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockSignatureUsecase)(nil).Verify), ctx, command)
+}
\ No newline at end of file
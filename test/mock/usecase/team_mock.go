@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/leaderboard/usecase/team/interface.go
+
+// Package socre is a generated GoMock package.
+package socre
+
+import (
+	context "context"
+	model "leaderboard/internal/leaderboard/domain/model"
+	team "leaderboard/internal/leaderboard/usecase/team"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTeamUsecase is a mock of TeamUsecase interface.
+type MockTeamUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamUsecaseMockRecorder
+}
+
+// MockTeamUsecaseMockRecorder is the mock recorder for MockTeamUsecase.
+type MockTeamUsecaseMockRecorder struct {
+	mock *MockTeamUsecase
+}
+
+// NewMockTeamUsecase creates a new mock instance.
+func NewMockTeamUsecase(ctrl *gomock.Controller) *MockTeamUsecase {
+	mock := &MockTeamUsecase{ctrl: ctrl}
+	mock.recorder = &MockTeamUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamUsecase) EXPECT() *MockTeamUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTeamUsecase) Get(ctx context.Context, id string) (*model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTeamUsecaseMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTeamUsecase)(nil).Get), ctx, id)
+}
+
+// Join mocks base method.
+func (m *MockTeamUsecase) Join(ctx context.Context, command *team.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockTeamUsecaseMockRecorder) Join(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockTeamUsecase)(nil).Join), ctx, command)
+}
+
+// Leave mocks base method.
+func (m *MockTeamUsecase) Leave(ctx context.Context, command *team.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leave", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Leave indicates an expected call of Leave.
+func (mr *MockTeamUsecaseMockRecorder) Leave(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockTeamUsecase)(nil).Leave), ctx, command)
+}
+
+// Save mocks base method.
+func (m *MockTeamUsecase) Save(ctx context.Context, command *team.SaveTeam) (*model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, command)
+	ret0, _ := ret[0].(*model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTeamUsecaseMockRecorder) Save(ctx, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTeamUsecase)(nil).Save), ctx, command)
+}
\ No newline at end of file